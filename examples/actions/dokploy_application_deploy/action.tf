@@ -0,0 +1,18 @@
+action "dokploy_application_deploy" "app" {
+  config {
+    application_id      = dokploy_application.app.id
+    wait_for_deployment = true
+    timeout             = "15m"
+  }
+}
+
+resource "terraform_data" "release" {
+  input = var.release_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.dokploy_application_deploy.app]
+    }
+  }
+}
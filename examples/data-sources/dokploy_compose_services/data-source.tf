@@ -0,0 +1,14 @@
+data "dokploy_compose_services" "shop" {
+  compose_id = dokploy_compose.shop.id
+}
+
+locals {
+  shop_services = { for service in data.dokploy_compose_services.shop.services : service.name => service }
+}
+
+resource "dokploy_environment_variables" "api" {
+  application_id = dokploy_application.api.id
+  variables = {
+    DATABASE_HOST = local.shop_services["db"].qualified_name
+  }
+}
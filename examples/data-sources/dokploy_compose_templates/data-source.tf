@@ -0,0 +1,7 @@
+data "dokploy_compose_templates" "analytics" {
+  tag = "analytics"
+}
+
+output "analytics_templates" {
+  value = data.dokploy_compose_templates.analytics.templates[*].id
+}
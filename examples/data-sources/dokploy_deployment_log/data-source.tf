@@ -0,0 +1,8 @@
+data "dokploy_deployment_log" "latest" {
+  application_id = dokploy_application.app.id
+  tail_lines     = 100
+}
+
+output "build_log" {
+  value = data.dokploy_deployment_log.latest.log
+}
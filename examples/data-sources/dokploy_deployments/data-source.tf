@@ -0,0 +1,7 @@
+data "dokploy_deployments" "app" {
+  application_id = dokploy_application.app.id
+}
+
+output "last_deployment_status" {
+  value = data.dokploy_deployments.app.deployments[0].status
+}
@@ -0,0 +1,16 @@
+data "dokploy_git_provider" "github" {
+  name          = "acme-github-app"
+  provider_type = "github"
+}
+
+resource "dokploy_application" "web" {
+  project_id     = dokploy_project.example.id
+  environment_id = dokploy_environment.production.id
+  name           = "web"
+
+  source_type       = "github"
+  github_id         = data.dokploy_git_provider.github.id
+  github_owner      = "acme"
+  github_repository = "web"
+  github_branch     = "main"
+}
@@ -0,0 +1,7 @@
+data "dokploy_git_providers" "gitlab" {
+  provider_type = "gitlab"
+}
+
+output "gitlab_provider_names" {
+  value = data.dokploy_git_providers.gitlab.git_providers[*].name
+}
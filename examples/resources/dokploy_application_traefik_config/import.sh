@@ -0,0 +1,2 @@
+# The Traefik config of an application is imported using the application ID
+terraform import dokploy_application_traefik_config.web "application-id-123"
@@ -0,0 +1,27 @@
+resource "dokploy_application_traefik_config" "web" {
+  application_id = dokploy_application.web.id
+  config         = <<-EOT
+    http:
+      routers:
+        web-router:
+          rule: Host(`web.example.com`)
+          service: web-service
+          middlewares:
+            - security-headers
+          entryPoints:
+            - web
+      middlewares:
+        security-headers:
+          headers:
+            frameDeny: true
+            contentTypeNosniff: true
+      services:
+        web-service:
+          loadBalancer:
+            servers:
+              - url: http://web-a1b2c3:3000
+            sticky:
+              cookie:
+                name: web_session
+  EOT
+}
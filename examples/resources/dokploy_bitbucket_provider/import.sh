@@ -0,0 +1,2 @@
+# Bitbucket providers can be imported using their bitbucket ID
+terraform import dokploy_bitbucket_provider.acme "bitbucket-id-123"
@@ -0,0 +1,13 @@
+variable "bitbucket_api_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "dokploy_bitbucket_provider" "acme" {
+  name                = "acme"
+  username            = "acme-bot"
+  email               = "bot@acme.dev"
+  workspace_name      = "acme"
+  api_token           = var.bitbucket_api_token
+  credentials_version = 1
+}
@@ -0,0 +1,3 @@
+# Compose stacks created from a template can be imported using their ID;
+# template_id is read from the stack name
+terraform import dokploy_compose_template.plausible "compose-id-123"
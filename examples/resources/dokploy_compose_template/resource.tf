@@ -0,0 +1,14 @@
+resource "dokploy_compose_template" "plausible" {
+  environment_id   = dokploy_environment.production.id
+  template_id      = "plausible"
+  deploy_on_create = true
+}
+
+# Override a generated variable. BASE_URL and the other generated variables
+# stay as the template created them.
+resource "dokploy_environment_variables" "plausible" {
+  compose_id = dokploy_compose_template.plausible.id
+  variables = merge(dokploy_compose_template.plausible.env, {
+    DISABLE_REGISTRATION = "true"
+  })
+}
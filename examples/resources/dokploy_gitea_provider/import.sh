@@ -0,0 +1,2 @@
+# Gitea providers can be imported using their gitea ID
+terraform import dokploy_gitea_provider.internal "gitea-id-123"
@@ -0,0 +1,23 @@
+variable "gitea_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "dokploy_gitea_provider" "internal" {
+  name                = "internal"
+  gitea_url           = "https://git.example.com"
+  access_token        = var.gitea_token
+  credentials_version = 1
+}
+
+resource "dokploy_compose" "stack" {
+  project_id     = dokploy_project.example.id
+  environment_id = dokploy_environment.production.id
+  name           = "stack"
+
+  source_type      = "gitea"
+  gitea_id         = dokploy_gitea_provider.internal.id
+  gitea_owner      = "ops"
+  gitea_repository = "stack"
+  gitea_branch     = "main"
+}
@@ -0,0 +1,2 @@
+# GitLab providers can be imported using their gitlab ID
+terraform import dokploy_gitlab_provider.self_hosted "gitlab-id-123"
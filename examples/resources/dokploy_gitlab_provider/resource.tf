@@ -0,0 +1,24 @@
+variable "gitlab_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "dokploy_gitlab_provider" "self_hosted" {
+  name                = "self-hosted"
+  gitlab_url          = "https://gitlab.example.com"
+  group_name          = "platform"
+  access_token        = var.gitlab_token
+  credentials_version = 1
+}
+
+resource "dokploy_application" "api" {
+  project_id     = dokploy_project.example.id
+  environment_id = dokploy_environment.production.id
+  name           = "api"
+
+  source_type       = "gitlab"
+  gitlab_id         = dokploy_gitlab_provider.self_hosted.id
+  gitlab_owner      = "platform"
+  gitlab_repository = "api"
+  gitlab_branch     = "main"
+}
@@ -0,0 +1,2 @@
+# Mounts can be imported using their ID; the owning service is looked up automatically
+terraform import dokploy_mount.data "mount-id-123"
@@ -0,0 +1,16 @@
+resource "dokploy_mount" "config" {
+  service_type = "compose"
+  service_id   = dokploy_compose.example.id
+  type         = "file"
+  mount_path   = "/etc/app/app.conf"
+  file_path    = "app.conf"
+  content      = file("${path.module}/app.conf")
+}
+
+resource "dokploy_mount" "data" {
+  service_type = "postgres"
+  service_id   = dokploy_database.example.id
+  type         = "bind"
+  mount_path   = "/var/lib/postgresql/data"
+  host_path    = "/srv/postgres"
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// cacheableEndpoints lists idempotent GET procedures whose responses may be
+// shared between callers. Listing endpoints that are polled for eventual
+// consistency (e.g. mounts.allNamedByApplicationId) must never be cached.
+var cacheableEndpoints = []string{
+	"project.one",
+	"application.one",
+	"compose.one",
+}
+
+func isCacheableEndpoint(endpoint string) bool {
+	procedure := endpoint
+	if idx := strings.Index(procedure, "?"); idx >= 0 {
+		procedure = procedure[:idx]
+	}
+	for _, candidate := range cacheableEndpoints {
+		if procedure == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+// responseCache memoizes GET responses for the lifetime of the provider
+// process, which Terraform scopes to a single plan, apply or refresh.
+// Concurrent requests for the same endpoint are collapsed into a single HTTP
+// call, and every mutation bumps the generation so stale responses are
+// neither served nor stored.
+type responseCache struct {
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string][]byte
+	group      singleflight.Group
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		entries: map[string][]byte{},
+	}
+}
+
+func (rc *responseCache) get(endpoint string, fetch func() ([]byte, error)) ([]byte, error) {
+	rc.mu.Lock()
+	if cached, ok := rc.entries[endpoint]; ok {
+		rc.mu.Unlock()
+		return cached, nil
+	}
+	generation := rc.generation
+	rc.mu.Unlock()
+
+	key := strconv.FormatUint(generation, 10) + "|" + endpoint
+	result, err, _ := rc.group.Do(key, func() (interface{}, error) {
+		resp, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		rc.mu.Lock()
+		if rc.generation == generation {
+			rc.entries[endpoint] = resp
+		}
+		rc.mu.Unlock()
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), nil
+}
+
+func (rc *responseCache) invalidate() {
+	rc.mu.Lock()
+	rc.generation++
+	rc.entries = map[string][]byte{}
+	rc.mu.Unlock()
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetApplication_CachesAndDeduplicatesReads(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/application.one" {
+			t.Errorf("unexpected endpoint called: %s", r.URL.Path)
+			return
+		}
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"applicationId":"app-123","name":"rssmate"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetApplication("app-123"); err != nil {
+				t.Errorf("GetApplication returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, err := c.GetApplication("app-123"); err != nil {
+		t.Fatalf("GetApplication returned error: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected a single application.one call, got %d", got)
+	}
+}
+
+func TestGetApplication_MutationInvalidatesCache(t *testing.T) {
+	name := "before"
+	oneCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.one":
+			oneCalls++
+			_, _ = w.Write([]byte(`{"applicationId":"app-123","name":"` + name + `"}`))
+		case "/application.deploy":
+			name = "after"
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if _, err := c.GetApplication("app-123"); err != nil {
+		t.Fatalf("GetApplication returned error: %v", err)
+	}
+	if err := c.DeployApplication("app-123"); err != nil {
+		t.Fatalf("DeployApplication returned error: %v", err)
+	}
+	app, err := c.GetApplication("app-123")
+	if err != nil {
+		t.Fatalf("GetApplication returned error: %v", err)
+	}
+	if app.Name != "after" {
+		t.Fatalf("expected fresh read after mutation, got name %q", app.Name)
+	}
+	if oneCalls != 2 {
+		t.Fatalf("expected 2 application.one calls, got %d", oneCalls)
+	}
+}
+
+func TestDisableRequestCache_AlwaysHitsAPI(t *testing.T) {
+	oneCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		oneCalls++
+		_, _ = w.Write([]byte(`{"projectId":"proj-1","name":"Project One"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	c.DisableRequestCache()
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetProject("proj-1"); err != nil {
+			t.Fatalf("GetProject returned error: %v", err)
+		}
+	}
+	if oneCalls != 2 {
+		t.Fatalf("expected 2 project.one calls with cache disabled, got %d", oneCalls)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 )
@@ -16,6 +19,10 @@ type DokployClient struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	locks    targetLocks
+	cache    *responseCache
+	throttle *requestThrottle
 }
 
 func NewDokployClient(baseURL, apiKey string) *DokployClient {
@@ -25,47 +32,163 @@ func NewDokployClient(baseURL, apiKey string) *DokployClient {
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		cache:    newResponseCache(),
+		throttle: newRequestThrottle(0, 0),
 	}
 }
 
+// SetRateLimit limits the client to requestsPerSecond requests per second and
+// at most maxConcurrent requests in flight. Zero disables the respective limit.
+func (c *DokployClient) SetRateLimit(requestsPerSecond float64, maxConcurrent int) {
+	c.throttle = newRequestThrottle(requestsPerSecond, maxConcurrent)
+}
+
+// DisableRequestCache turns off response caching and request deduplication,
+// so every read goes to the Dokploy API.
+func (c *DokployClient) DisableRequestCache() {
+	c.cache = nil
+}
+
 func (c *DokployClient) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+	if c.cache == nil {
+		return c.sendRequest(method, endpoint, body)
+	}
+
+	if method == "GET" && isCacheableEndpoint(endpoint) {
+		return c.cache.get(endpoint, func() ([]byte, error) {
+			return c.sendRequest(method, endpoint, body)
+		})
+	}
+
+	resp, err := c.sendRequest(method, endpoint, body)
+	if method != "GET" {
+		// Invalidate even on error: the write may have been applied server-side.
+		c.cache.invalidate()
+	}
+	return resp, err
+}
+
+func (c *DokployClient) sendRequest(method, endpoint string, body interface{}) ([]byte, error) {
+	var jsonBytes []byte
 	if body != nil {
-		jsonBytes, err := json.Marshal(body)
+		var err error
+		jsonBytes, err = json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		reqBody = bytes.NewBuffer(jsonBytes)
 	}
 
 	url := fmt.Sprintf("%s/%s", c.BaseURL, endpoint)
 
-	req, err := http.NewRequest(method, url, reqBody)
-	if err != nil {
-		return nil, err
-	}
+	return c.execute(func() (*http.Request, error) {
+		var reqBody io.Reader
+		if jsonBytes != nil {
+			reqBody = bytes.NewReader(jsonBytes)
+		}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", c.APIKey)
+		req, err := http.NewRequest(method, url, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	})
+}
+
+// multipartFile is the file part of a multipart/form-data request.
+type multipartFile struct {
+	Field    string
+	Name     string
+	Content  []byte
+	MIMEType string
+}
 
-	resp, err := c.HTTPClient.Do(req)
+// sendMultipart posts fields and a single file as multipart/form-data. The
+// body is encoded once and replayed if the request is retried.
+func (c *DokployClient) sendMultipart(endpoint string, fields map[string]string, file multipartFile) ([]byte, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writer.WriteField(key, fields[key]); err != nil {
+			return nil, err
+		}
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, file.Field, file.Name))
+	header.Set("Content-Type", file.MIMEType)
+	part, err := writer.CreatePart(header)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
+	if _, err := part.Write(file.Content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
 		return nil, err
 	}
 
-	// fmt.Fprintf(os.Stderr, "DEBUG RESPONSE [%s]: %s\n", endpoint, string(respBytes))
+	url := fmt.Sprintf("%s/%s", c.BaseURL, endpoint)
+	resp, err := c.execute(func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", url, bytes.NewReader(body.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req, nil
+	})
+	if c.cache != nil {
+		c.cache.invalidate()
+	}
+	return resp, err
+}
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(respBytes))
+// execute sends the request built by newRequest through the client throttle,
+// retrying when Dokploy or a reverse proxy asks to back off. newRequest is
+// called once per attempt so request bodies can be replayed.
+func (c *DokployClient) execute(newRequest func() (*http.Request, error)) ([]byte, error) {
+	throttle := c.throttle
+	if throttle == nil {
+		throttle = newRequestThrottle(0, 0)
 	}
 
-	return respBytes, nil
+	for attempt := 0; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("x-api-key", c.APIKey)
+
+		release := throttle.acquire()
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		respBytes, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		release()
+		if err != nil {
+			return nil, err
+		}
+
+		// fmt.Fprintf(os.Stderr, "DEBUG RESPONSE [%s]: %s\n", req.URL.Path, string(respBytes))
+
+		if delay, ok := throttledRetryDelay(req.Method, resp, attempt); ok && attempt < maxThrottledRetries {
+			throttle.pause(delay)
+			continue
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, fmt.Errorf("API error: %s - %s", resp.Status, string(respBytes))
+		}
+
+		return respBytes, nil
+	}
 }
 
 // --- Settings / Traefik ---
@@ -157,6 +280,30 @@ func (c *DokployClient) ReloadTraefik(serverID *string) error {
 	return err
 }
 
+// ReadApplicationTraefikConfig returns the dynamic Traefik file Dokploy keeps
+// for an application.
+func (c *DokployClient) ReadApplicationTraefikConfig(applicationID string) (string, error) {
+	endpoint := fmt.Sprintf("application.readTraefikConfig?applicationId=%s", url.QueryEscape(applicationID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	return parseTraefikConfigResponse(resp)
+}
+
+// UpdateApplicationTraefikConfig replaces the dynamic Traefik file of an
+// application. Dokploy rewrites the same file when domains change, so the
+// write is serialized with domain changes.
+func (c *DokployClient) UpdateApplicationTraefikConfig(applicationID, config string) error {
+	defer c.lockTarget("application", applicationID, lockFamilyDomains)()
+
+	_, err := c.doRequest("POST", "application.updateTraefikConfig", map[string]interface{}{
+		"applicationId": applicationID,
+		"traefikConfig": config,
+	})
+	return err
+}
+
 func parseTraefikConfigResponse(resp []byte) (string, error) {
 	trimmed := strings.TrimSpace(string(resp))
 	if trimmed == "" || trimmed == "null" {
@@ -369,6 +516,10 @@ func (c *DokployClient) DeleteProject(id string) error {
 }
 
 func (c *DokployClient) UpdateProject(id, name, description string) (*Project, error) {
+	// UpdateProjectEnv re-sends name and description with every env write, so
+	// renames share its lock to avoid being overwritten with stale values.
+	defer c.lockTarget("project", id, lockFamilyEnv)()
+
 	payload := map[string]string{
 		"projectId":   id,
 		"name":        name,
@@ -387,6 +538,8 @@ func (c *DokployClient) UpdateProject(id, name, description string) (*Project, e
 }
 
 func (c *DokployClient) UpdateProjectEnv(projectID string, updateFn func(envMap map[string]string)) error {
+	defer c.lockTarget("project", projectID, lockFamilyEnv)()
+
 	var lastErr error
 
 	for i := 0; i < 5; i++ {
@@ -448,6 +601,7 @@ type Environment struct {
 	Mariadb     []Database `json:"mariadb"`
 	Mongo       []Database `json:"mongo"`
 	Redis       []Database `json:"redis"`
+	Compose     []Compose  `json:"compose"`
 }
 
 func (c *DokployClient) CreateEnvironment(projectID, name, description string) (*Environment, error) {
@@ -523,6 +677,8 @@ type Application struct {
 	DockerContextPath string   `json:"dockerContextPath"`
 	DockerBuildStage  string   `json:"dockerBuildStage"`
 	Env               string   `json:"env"`
+	BuildArgs         string   `json:"buildArgs"`
+	BuildSecrets      string   `json:"buildSecrets"`
 	Domains           []Domain `json:"domains"`
 	Ports             []Port   `json:"ports"`
 	Mounts            []Mount  `json:"mounts"`
@@ -535,6 +691,9 @@ type Application struct {
 	CustomGitBuildPath string `json:"customGitBuildPath"`
 	Username           string `json:"username"`
 	Password           string `json:"password"`
+	// Docker provider fields
+	DockerImage string `json:"dockerImage"`
+	RegistryURL string `json:"registryUrl"`
 	// GitHub Provider fields
 	GithubRepository string            `json:"githubRepository"`
 	GithubOwner      string            `json:"owner"`
@@ -545,6 +704,31 @@ type Application struct {
 	EnableSubmodules bool              `json:"enableSubmodules"`
 	TriggerType      string            `json:"triggerType"`
 	LabelsSwarm      map[string]string `json:"labelsSwarm"`
+	// GitLab, Bitbucket and Gitea provider fields
+	GitlabID            string `json:"gitlabId"`
+	GitlabRepository    string `json:"gitlabRepository"`
+	GitlabOwner         string `json:"gitlabOwner"`
+	GitlabBranch        string `json:"gitlabBranch"`
+	GitlabBuildPath     string `json:"gitlabBuildPath"`
+	GitlabProjectID     *int64 `json:"gitlabProjectId"`
+	BitbucketID         string `json:"bitbucketId"`
+	BitbucketRepository string `json:"bitbucketRepository"`
+	BitbucketOwner      string `json:"bitbucketOwner"`
+	BitbucketBranch     string `json:"bitbucketBranch"`
+	BitbucketBuildPath  string `json:"bitbucketBuildPath"`
+	GiteaID             string `json:"giteaId"`
+	GiteaRepository     string `json:"giteaRepository"`
+	GiteaOwner          string `json:"giteaOwner"`
+	GiteaBranch         string `json:"giteaBranch"`
+	GiteaBuildPath      string `json:"giteaBuildPath"`
+	// Swarm settings
+	HealthCheckSwarm    *HealthCheckSwarm   `json:"healthCheckSwarm"`
+	RestartPolicySwarm  *RestartPolicySwarm `json:"restartPolicySwarm"`
+	PlacementSwarm      *PlacementSwarm     `json:"placementSwarm"`
+	UpdateConfigSwarm   *UpdateConfigSwarm  `json:"updateConfigSwarm"`
+	RollbackConfigSwarm *UpdateConfigSwarm  `json:"rollbackConfigSwarm"`
+	ModeSwarm           *ModeSwarm          `json:"modeSwarm"`
+	NetworkSwarm        []NetworkSwarm      `json:"networkSwarm"`
 	// Preview deployment fields
 	IsPreviewDeploymentsActive            *bool    `json:"isPreviewDeploymentsActive"`
 	PreviewWildcard                       string   `json:"previewWildcard"`
@@ -558,6 +742,42 @@ type Application struct {
 	PreviewEnv                            string   `json:"previewEnv"`
 	PreviewBuildArgs                      string   `json:"previewBuildArgs"`
 	PreviewLabels                         []string `json:"previewLabels"`
+	// Rollback fields
+	RollbackActive     *bool  `json:"rollbackActive"`
+	RollbackRegistryID string `json:"rollbackRegistryId"`
+	// Resource fields
+	MemoryLimit       ResourceQuantity `json:"memoryLimit"`
+	MemoryReservation ResourceQuantity `json:"memoryReservation"`
+	CPULimit          ResourceQuantity `json:"cpuLimit"`
+	CPUReservation    ResourceQuantity `json:"cpuReservation"`
+	Replicas          *int64           `json:"replicas"`
+	Command           string           `json:"command"`
+}
+
+// ResourceQuantity holds a memory (bytes) or CPU (nano CPUs) value. Dokploy
+// stores these as text, but older versions return them as numbers.
+type ResourceQuantity string
+
+func (q *ResourceQuantity) UnmarshalJSON(data []byte) error {
+	trimmed := strings.TrimSpace(string(data))
+	if trimmed == "null" {
+		*q = ""
+		return nil
+	}
+	if strings.HasPrefix(trimmed, `"`) {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*q = ResourceQuantity(value)
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	*q = ResourceQuantity(number.String())
+	return nil
 }
 
 func (c *DokployClient) CreateApplication(app Application) (*Application, error) {
@@ -630,10 +850,15 @@ func (c *DokployClient) CreateApplication(app Application) (*Application, error)
 		updatePayload["labelsSwarm"] = app.LabelsSwarm
 	}
 	addPreviewApplicationPayload(updatePayload, app)
+	addRollbackApplicationPayload(updatePayload, app)
+	addResourceApplicationPayload(updatePayload, app)
+	addSwarmApplicationPayload(updatePayload, app)
 
 	// Ensure defaults
 	if app.SourceType == "" {
-		if app.CustomGitUrl != "" {
+		if app.DockerImage != "" {
+			updatePayload["sourceType"] = "docker"
+		} else if app.CustomGitUrl != "" {
 			updatePayload["sourceType"] = "git"
 		} else {
 			updatePayload["sourceType"] = "github"
@@ -675,6 +900,8 @@ func (c *DokployClient) GetApplication(id string) (*Application, error) {
 }
 
 func (c *DokployClient) UpdateApplication(app Application) (*Application, error) {
+	defer c.lockTarget("application", app.ID, lockFamilyConfig)()
+
 	payload := map[string]interface{}{
 		"applicationId": app.ID,
 		"name":          app.Name,
@@ -683,6 +910,11 @@ func (c *DokployClient) UpdateApplication(app Application) (*Application, error)
 		"sourceType":    app.SourceType,
 		"autoDeploy":    app.AutoDeploy,
 	}
+	// Settings left empty are reset rather than omitted, so removing them
+	// from the configuration also removes them in Dokploy.
+	for key, value := range applicationResetValues {
+		payload[key] = value
+	}
 	// Optional fields
 	if app.RepositoryURL != "" {
 		payload["repository"] = app.RepositoryURL
@@ -722,6 +954,9 @@ func (c *DokployClient) UpdateApplication(app Application) (*Application, error)
 		payload["environmentId"] = app.EnvironmentID
 	}
 	addPreviewApplicationPayload(payload, app)
+	addRollbackApplicationPayload(payload, app)
+	addResourceApplicationPayload(payload, app)
+	addSwarmApplicationPayload(payload, app)
 
 	resp, err := c.doRequest("POST", "application.update", payload)
 	if err != nil {
@@ -747,6 +982,45 @@ func (c *DokployClient) UpdateApplication(app Application) (*Application, error)
 	return nil, fmt.Errorf("failed to parse application.update response for application %s: %s", app.ID, string(resp))
 }
 
+// applicationResetValues holds what UpdateApplication sends for optional
+// settings that are not set: null for nullable columns and the Dokploy
+// default for the others.
+var applicationResetValues = map[string]interface{}{
+	"customGitUrl":                          nil,
+	"customGitBranch":                       nil,
+	"customGitSSHKeyId":                     nil,
+	"customGitBuildPath":                    nil,
+	"username":                              nil,
+	"labelsSwarm":                           nil,
+	"isPreviewDeploymentsActive":            false,
+	"previewWildcard":                       nil,
+	"previewPort":                           int64(3000),
+	"previewPath":                           "/",
+	"previewHttps":                          false,
+	"previewCertificateType":                "none",
+	"previewCustomCertResolver":             nil,
+	"previewLimit":                          int64(3),
+	"previewRequireCollaboratorPermissions": true,
+	"previewEnv":                            nil,
+	"previewBuildArgs":                      nil,
+	"previewLabels":                         nil,
+	"rollbackActive":                        false,
+	"rollbackRegistryId":                    nil,
+	"memoryLimit":                           nil,
+	"memoryReservation":                     nil,
+	"cpuLimit":                              nil,
+	"cpuReservation":                        nil,
+	"replicas":                              int64(1),
+	"command":                               nil,
+	"healthCheckSwarm":                      nil,
+	"restartPolicySwarm":                    nil,
+	"placementSwarm":                        nil,
+	"updateConfigSwarm":                     nil,
+	"rollbackConfigSwarm":                   nil,
+	"modeSwarm":                             nil,
+	"networkSwarm":                          nil,
+}
+
 func addPreviewApplicationPayload(payload map[string]interface{}, app Application) {
 	if app.IsPreviewDeploymentsActive != nil {
 		payload["isPreviewDeploymentsActive"] = *app.IsPreviewDeploymentsActive
@@ -786,6 +1060,36 @@ func addPreviewApplicationPayload(payload map[string]interface{}, app Applicatio
 	}
 }
 
+func addRollbackApplicationPayload(payload map[string]interface{}, app Application) {
+	if app.RollbackActive != nil {
+		payload["rollbackActive"] = *app.RollbackActive
+	}
+	if app.RollbackRegistryID != "" {
+		payload["rollbackRegistryId"] = app.RollbackRegistryID
+	}
+}
+
+func addResourceApplicationPayload(payload map[string]interface{}, app Application) {
+	if app.MemoryLimit != "" {
+		payload["memoryLimit"] = string(app.MemoryLimit)
+	}
+	if app.MemoryReservation != "" {
+		payload["memoryReservation"] = string(app.MemoryReservation)
+	}
+	if app.CPULimit != "" {
+		payload["cpuLimit"] = string(app.CPULimit)
+	}
+	if app.CPUReservation != "" {
+		payload["cpuReservation"] = string(app.CPUReservation)
+	}
+	if app.Replicas != nil {
+		payload["replicas"] = *app.Replicas
+	}
+	if app.Command != "" {
+		payload["command"] = app.Command
+	}
+}
+
 func (c *DokployClient) DeleteApplication(id string) error {
 	// Best-effort stop before deletion to make teardown explicit and predictable.
 	// Ignore stop errors; delete call should still reconcile the final state.
@@ -810,6 +1114,8 @@ func (c *DokployClient) DeleteApplication(id string) error {
 }
 
 func (c *DokployClient) SaveGithubProvider(appID string, githubConfig map[string]interface{}) error {
+	defer c.lockTarget("application", appID, lockFamilyConfig)()
+
 	payload := map[string]interface{}{
 		"applicationId": appID,
 	}
@@ -823,6 +1129,52 @@ func (c *DokployClient) SaveGithubProvider(appID string, githubConfig map[string
 	return err
 }
 
+// DockerProvider configures an application to run a prebuilt image.
+type DockerProvider struct {
+	DockerImage string
+	Username    string
+	Password    string
+	RegistryURL string
+}
+
+func (c *DokployClient) SaveDockerProvider(appID string, provider DockerProvider) error {
+	defer c.lockTarget("application", appID, lockFamilyConfig)()
+
+	payload := map[string]interface{}{
+		"applicationId": appID,
+		"dockerImage":   provider.DockerImage,
+		"username":      nil,
+		"password":      nil,
+		"registryUrl":   nil,
+	}
+	if provider.Username != "" {
+		payload["username"] = provider.Username
+	}
+	if provider.Password != "" {
+		payload["password"] = provider.Password
+	}
+	if provider.RegistryURL != "" {
+		payload["registryUrl"] = provider.RegistryURL
+	}
+
+	_, err := c.doRequest("POST", "application.saveDockerProvider", payload)
+	return err
+}
+
+// DropDeployApplication uploads a zip archive as the source of a "drop"
+// application. Dokploy extracts it and starts a deployment.
+func (c *DokployClient) DropDeployApplication(appID string, archive []byte) error {
+	_, err := c.sendMultipart("application.dropDeployment", map[string]string{
+		"applicationId": appID,
+	}, multipartFile{
+		Field:    "zip",
+		Name:     "source.zip",
+		Content:  archive,
+		MIMEType: "application/zip",
+	})
+	return err
+}
+
 func (c *DokployClient) DeployApplication(id string) error {
 	payload := map[string]string{
 		"applicationId": id,
@@ -844,16 +1196,110 @@ func (c *DokployClient) StopApplication(id string) error {
 type Mount struct {
 	ID            string `json:"mountId"`
 	ApplicationID string `json:"applicationId"`
+	ComposeID     string `json:"composeId"`
+	PostgresID    string `json:"postgresId"`
+	MysqlID       string `json:"mysqlId"`
+	MariadbID     string `json:"mariadbId"`
+	MongoID       string `json:"mongoId"`
+	RedisID       string `json:"redisId"`
 	MountType     string `json:"mountType"`
 	Type          string `json:"type"`
 	MountPath     string `json:"mountPath"`
 	VolumeName    string `json:"volumeName"`
 	HostPath      string `json:"hostPath"`
+	Content       string `json:"content"`
+	FilePath      string `json:"filePath"`
 	ServiceType   string `json:"serviceType"`
 	ServiceID     string `json:"serviceId"`
 }
 
+// mountServiceIDKeys maps Dokploy's mount service types to the ID field of
+// the owning service.
+var mountServiceIDKeys = map[string]string{
+	"application": "applicationId",
+	"compose":     "composeId",
+	"postgres":    "postgresId",
+	"mysql":       "mysqlId",
+	"mariadb":     "mariadbId",
+	"mongo":       "mongoId",
+	"redis":       "redisId",
+}
+
+// IsMountServiceType reports whether serviceType can own mounts.
+func IsMountServiceType(serviceType string) bool {
+	_, ok := mountServiceIDKeys[serviceType]
+	return ok
+}
+
+// Target returns the service type and ID that own the mount. Mounts without
+// a service type belong to applications.
+func (m Mount) Target() (string, string) {
+	ids := map[string]string{
+		"application": m.ApplicationID,
+		"compose":     m.ComposeID,
+		"postgres":    m.PostgresID,
+		"mysql":       m.MysqlID,
+		"mariadb":     m.MariadbID,
+		"mongo":       m.MongoID,
+		"redis":       m.RedisID,
+	}
+
+	serviceType := strings.TrimSpace(m.ServiceType)
+	if serviceID := strings.TrimSpace(m.ServiceID); serviceID != "" {
+		if serviceType == "" {
+			serviceType = "application"
+		}
+		return serviceType, serviceID
+	}
+	if serviceType != "" {
+		return serviceType, ids[serviceType]
+	}
+	for _, candidate := range []string{"application", "compose", "postgres", "mysql", "mariadb", "mongo", "redis"} {
+		if ids[candidate] != "" {
+			return candidate, ids[candidate]
+		}
+	}
+	return "", ""
+}
+
+// EffectiveType returns the mount type, which Dokploy reports as either type
+// or mountType depending on the endpoint.
+func (m Mount) EffectiveType() string {
+	if mountType := strings.TrimSpace(m.Type); mountType != "" {
+		return mountType
+	}
+	return strings.TrimSpace(m.MountType)
+}
+
+func addMountContentPayload(payload map[string]interface{}, mount Mount) {
+	if strings.TrimSpace(mount.VolumeName) != "" {
+		payload["volumeName"] = mount.VolumeName
+	}
+	if strings.TrimSpace(mount.HostPath) != "" {
+		payload["hostPath"] = mount.HostPath
+	}
+	if mount.Content != "" {
+		payload["content"] = mount.Content
+	}
+	if strings.TrimSpace(mount.FilePath) != "" {
+		payload["filePath"] = mount.FilePath
+	}
+}
+
 func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
+	serviceType := strings.TrimSpace(mount.ServiceType)
+	if serviceType == "" {
+		serviceType = "application"
+	}
+	serviceID := strings.TrimSpace(mount.ServiceID)
+	if serviceID == "" {
+		serviceID = mount.ApplicationID
+	}
+	if !IsMountServiceType(serviceType) {
+		return nil, fmt.Errorf("unsupported mount service type: %s", serviceType)
+	}
+	defer c.lockTarget(serviceType, serviceID, lockFamilyMounts)()
+
 	mountType := strings.TrimSpace(mount.MountType)
 	if mountType == "" {
 		mountType = strings.TrimSpace(mount.Type)
@@ -864,16 +1310,11 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 
 	payload := map[string]interface{}{
 		"type":        mountType,
-		"serviceType": "application",
-		"serviceId":   mount.ApplicationID,
+		"serviceType": serviceType,
+		"serviceId":   serviceID,
 		"mountPath":   mount.MountPath,
 	}
-	if strings.TrimSpace(mount.VolumeName) != "" {
-		payload["volumeName"] = mount.VolumeName
-	}
-	if strings.TrimSpace(mount.HostPath) != "" {
-		payload["hostPath"] = mount.HostPath
-	}
+	addMountContentPayload(payload, mount)
 
 	resp, err := c.doRequest("POST", "mounts.create", payload)
 	if err != nil {
@@ -893,7 +1334,7 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 	}
 
 	if strings.TrimSpace(string(resp)) == "true" {
-		created, err := c.findMountBySignature(mount.ApplicationID, mountType, mount.MountPath, mount.VolumeName)
+		created, err := c.findMountBySignature(serviceType, serviceID, mountType, mount.MountPath, mount.VolumeName)
 		if err == nil {
 			return created, nil
 		}
@@ -907,12 +1348,14 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 			MountPath:     mount.MountPath,
 			VolumeName:    mount.VolumeName,
 			HostPath:      mount.HostPath,
-			ServiceType:   "application",
-			ServiceID:     mount.ApplicationID,
+			Content:       mount.Content,
+			FilePath:      mount.FilePath,
+			ServiceType:   serviceType,
+			ServiceID:     serviceID,
 		}, nil
 	}
 
-	created, err := c.findMountBySignature(mount.ApplicationID, mountType, mount.MountPath, mount.VolumeName)
+	created, err := c.findMountBySignature(serviceType, serviceID, mountType, mount.MountPath, mount.VolumeName)
 	if err == nil {
 		return created, nil
 	}
@@ -926,15 +1369,17 @@ func (c *DokployClient) CreateMount(mount Mount) (*Mount, error) {
 		MountPath:     mount.MountPath,
 		VolumeName:    mount.VolumeName,
 		HostPath:      mount.HostPath,
-		ServiceType:   "application",
-		ServiceID:     mount.ApplicationID,
+		Content:       mount.Content,
+		FilePath:      mount.FilePath,
+		ServiceType:   serviceType,
+		ServiceID:     serviceID,
 	}, nil
 }
 
-func (c *DokployClient) findMountBySignature(applicationID, mountType, mountPath, volumeName string) (*Mount, error) {
+func (c *DokployClient) findMountBySignature(serviceType, serviceID, mountType, mountPath, volumeName string) (*Mount, error) {
 	var lastErr error
 	for i := 0; i < 8; i++ {
-		mounts, err := c.ListMountsByApplication(applicationID)
+		mounts, err := c.ListMounts(serviceType, serviceID)
 		if err != nil {
 			lastErr = err
 			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
@@ -942,24 +1387,7 @@ func (c *DokployClient) findMountBySignature(applicationID, mountType, mountPath
 		}
 
 		for _, existing := range mounts {
-			existingType := strings.TrimSpace(existing.MountType)
-			if existingType == "" {
-				existingType = strings.TrimSpace(existing.Type)
-			}
-			mountTypeMatches := strings.TrimSpace(mountType) == "" || strings.EqualFold(existingType, mountType)
-
-			existingPath := strings.TrimSuffix(strings.TrimSpace(existing.MountPath), "/")
-			requestedPath := strings.TrimSuffix(strings.TrimSpace(mountPath), "/")
-			pathMatches := existingPath == requestedPath
-
-			existingVolume := strings.TrimSpace(existing.VolumeName)
-			requestedVolume := strings.TrimSpace(volumeName)
-			volumeNameMatches := requestedVolume == "" ||
-				existingVolume == requestedVolume ||
-				strings.HasSuffix(existingVolume, "_"+requestedVolume) ||
-				strings.HasPrefix(existingVolume, requestedVolume+"_")
-
-			if mountTypeMatches && pathMatches && volumeNameMatches {
+			if MountMatches(existing, mountType, mountPath, volumeName) {
 				return &existing, nil
 			}
 		}
@@ -968,18 +1396,43 @@ func (c *DokployClient) findMountBySignature(applicationID, mountType, mountPath
 	}
 
 	if lastErr != nil {
-		return nil, fmt.Errorf("mount created but mount lookup failed for application %s: %w", applicationID, lastErr)
+		return nil, fmt.Errorf("mount created but mount lookup failed for %s %s: %w", serviceType, serviceID, lastErr)
 	}
 
 	return nil, fmt.Errorf(
-		"mount created but not found on application %s (mountType=%q, mountPath=%q, volumeName=%q)",
-		applicationID,
+		"mount created but not found on %s %s (mountType=%q, mountPath=%q, volumeName=%q)",
+		serviceType,
+		serviceID,
 		mountType,
 		mountPath,
 		volumeName,
 	)
 }
 
+// MountMatches reports whether an existing mount has the given signature. An
+// empty mountType or volumeName matches anything, and Dokploy may prefix or
+// suffix volume names with the service's appName.
+func MountMatches(existing Mount, mountType, mountPath, volumeName string) bool {
+	existingType := strings.TrimSpace(existing.MountType)
+	if existingType == "" {
+		existingType = strings.TrimSpace(existing.Type)
+	}
+	mountTypeMatches := strings.TrimSpace(mountType) == "" || strings.EqualFold(existingType, mountType)
+
+	existingPath := strings.TrimSuffix(strings.TrimSpace(existing.MountPath), "/")
+	requestedPath := strings.TrimSuffix(strings.TrimSpace(mountPath), "/")
+	pathMatches := existingPath == requestedPath
+
+	existingVolume := strings.TrimSpace(existing.VolumeName)
+	requestedVolume := strings.TrimSpace(volumeName)
+	volumeNameMatches := requestedVolume == "" ||
+		existingVolume == requestedVolume ||
+		strings.HasSuffix(existingVolume, "_"+requestedVolume) ||
+		strings.HasPrefix(existingVolume, requestedVolume+"_")
+
+	return mountTypeMatches && pathMatches && volumeNameMatches
+}
+
 func (c *DokployClient) ListMountsByApplication(applicationID string) ([]Mount, error) {
 	endpoint := fmt.Sprintf("mounts.allNamedByApplicationId?applicationId=%s", applicationID)
 	resp, err := c.doRequest("GET", endpoint, nil)
@@ -1021,9 +1474,115 @@ func (c *DokployClient) ListMountsByApplication(applicationID string) ([]Mount,
 	return nil, fmt.Errorf("failed to parse mounts.allNamedByApplicationId response: %s", string(resp))
 }
 
-func (c *DokployClient) DeleteMount(id string) error {
+// ListMounts lists the mounts of any service that can own mounts. Only
+// applications have a dedicated listing endpoint; other services embed their
+// mounts in their .one response.
+func (c *DokployClient) ListMounts(serviceType, serviceID string) ([]Mount, error) {
+	if serviceType == "" || serviceType == "application" {
+		return c.ListMountsByApplication(serviceID)
+	}
+	idKey, ok := mountServiceIDKeys[serviceType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported mount service type: %s", serviceType)
+	}
+
+	// Bypass the read cache: compose.one is cacheable, but this listing is
+	// polled by findMountBySignature right after a write.
+	endpoint := fmt.Sprintf("%s.one?%s=%s", serviceType, idKey, serviceID)
+	resp, err := c.sendRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// A service without mounts still carries an empty mounts field. A response
+	// without one is not a service, and reporting no mounts for it would make
+	// dokploy_mount drop mounts that still exist.
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(resp, &fields); err == nil {
+		if inner, ok := fields[serviceType]; ok {
+			if err := json.Unmarshal(inner, &fields); err != nil {
+				fields = nil
+			}
+		}
+		if raw, ok := fields["mounts"]; ok {
+			mounts := []Mount{}
+			if err := json.Unmarshal(raw, &mounts); err == nil {
+				if mounts == nil {
+					mounts = []Mount{}
+				}
+				return mounts, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("failed to parse %s.one response: %s", serviceType, string(resp))
+}
+
+func (c *DokployClient) GetMount(id string) (*Mount, error) {
+	endpoint := fmt.Sprintf("mounts.one?mountId=%s", id)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Mount Mount `json:"mount"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Mount.ID != "" {
+		return &wrapper.Mount, nil
+	}
+
+	var result Mount
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	if result.ID == "" {
+		return nil, fmt.Errorf("failed to parse mounts.one response: missing mountId")
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) UpdateMount(mount Mount) (*Mount, error) {
+	serviceType, serviceID := mount.Target()
+	defer c.lockTarget(serviceType, serviceID, lockFamilyMounts)()
+
+	payload := map[string]interface{}{
+		"mountId":   mount.ID,
+		"mountPath": mount.MountPath,
+	}
+	if mountType := mount.EffectiveType(); mountType != "" {
+		payload["type"] = mountType
+	}
+	addMountContentPayload(payload, mount)
+
+	resp, err := c.doRequest("POST", "mounts.update", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper struct {
+		Mount Mount `json:"mount"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Mount.ID != "" {
+		return &wrapper.Mount, nil
+	}
+
+	var result Mount
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	return c.GetMount(mount.ID)
+}
+
+// DeleteMount removes a mount. The mount's service type and ID select the
+// lock shared with CreateMount and UpdateMount; only ID is sent to Dokploy.
+func (c *DokployClient) DeleteMount(mount Mount) error {
+	serviceType, serviceID := mount.Target()
+	defer c.lockTarget(serviceType, serviceID, lockFamilyMounts)()
+
 	payload := map[string]string{
-		"mountId": id,
+		"mountId": mount.ID,
 	}
 	_, err := c.doRequest("POST", "mounts.remove", payload)
 	if err == nil {
@@ -1056,6 +1615,8 @@ type Compose struct {
 	AppName           string   `json:"appName"`
 	ProjectID         string   `json:"projectId"`
 	EnvironmentID     string   `json:"environmentId"`
+	ServerID          string   `json:"serverId"`
+	CreatedAt         string   `json:"createdAt"`
 	ComposeFile       string   `json:"composeFile"`
 	SourceType        string   `json:"sourceType"`
 	CustomGitUrl      string   `json:"customGitUrl"`
@@ -1065,15 +1626,49 @@ type Compose struct {
 	AutoDeploy        bool     `json:"autoDeploy"`
 	Env               string   `json:"env"`
 	Domains           []Domain `json:"domains"`
+	// ComposeType is docker-compose or stack (Docker Swarm).
+	ComposeType        string `json:"composeType"`
+	IsolatedDeployment bool   `json:"isolatedDeployment"`
+	Randomize          bool   `json:"randomize"`
+	// GitHub provider fields
+	GithubID    string `json:"githubId"`
+	Repository  string `json:"repository"`
+	Owner       string `json:"owner"`
+	Branch      string `json:"branch"`
+	TriggerType string `json:"triggerType"`
+	// GitLab, Bitbucket and Gitea provider fields
+	GitlabID            string   `json:"gitlabId"`
+	GitlabRepository    string   `json:"gitlabRepository"`
+	GitlabOwner         string   `json:"gitlabOwner"`
+	GitlabBranch        string   `json:"gitlabBranch"`
+	GitlabProjectID     *int64   `json:"gitlabProjectId"`
+	BitbucketID         string   `json:"bitbucketId"`
+	BitbucketRepository string   `json:"bitbucketRepository"`
+	BitbucketOwner      string   `json:"bitbucketOwner"`
+	BitbucketBranch     string   `json:"bitbucketBranch"`
+	GiteaID             string   `json:"giteaId"`
+	GiteaRepository     string   `json:"giteaRepository"`
+	GiteaOwner          string   `json:"giteaOwner"`
+	GiteaBranch         string   `json:"giteaBranch"`
+	WatchPaths          []string `json:"watchPaths"`
+	EnableSubmodules    bool     `json:"enableSubmodules"`
 }
 
 func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 	// 1. Create minimal compose
+	composeType := comp.ComposeType
+	if composeType == "" {
+		composeType = "docker-compose"
+	}
+	appName := comp.AppName
+	if appName == "" {
+		appName = comp.Name
+	}
 	payload := map[string]string{
 		"environmentId": comp.EnvironmentID,
 		"name":          comp.Name,
-		"composeType":   "docker-compose",
-		"appName":       comp.Name,
+		"composeType":   composeType,
+		"appName":       appName,
 	}
 
 	// If raw content provided, include it
@@ -1086,13 +1681,12 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 		return nil, err
 	}
 
+	// Dokploy returns either the compose itself or {"compose": {...}}. Both
+	// still need the update below to apply the remaining settings.
 	var wrapper struct {
 		Compose Compose `json:"compose"`
 	}
-	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Compose.ID != "" {
-		return &wrapper.Compose, nil
-	}
-
+	_ = json.Unmarshal(resp, &wrapper)
 	createdComp := wrapper.Compose
 	if createdComp.ID == "" {
 		if err := json.Unmarshal(resp, &createdComp); err != nil {
@@ -1102,10 +1696,17 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 
 	// 2. Update with Git configuration if necessary
 	updatePayload := map[string]interface{}{
-		"composeId":  createdComp.ID,
-		"name":       comp.Name,
-		"sourceType": comp.SourceType,
-		"autoDeploy": comp.AutoDeploy,
+		"composeId":          createdComp.ID,
+		"name":               comp.Name,
+		"sourceType":         comp.SourceType,
+		"autoDeploy":         comp.AutoDeploy,
+		"isolatedDeployment": comp.IsolatedDeployment,
+		"randomize":          comp.Randomize,
+	}
+	// compose.create appends a random suffix to appName. An explicit name is
+	// set again so it is used as given.
+	if comp.AppName != "" {
+		updatePayload["appName"] = comp.AppName
 	}
 
 	if comp.CustomGitUrl != "" {
@@ -1144,6 +1745,7 @@ func (c *DokployClient) CreateCompose(comp Compose) (*Compose, error) {
 	}
 
 	var updateResult Compose
+	wrapper.Compose = Compose{}
 	if err := json.Unmarshal(respUpdate, &wrapper); err == nil && wrapper.Compose.ID != "" {
 		return &wrapper.Compose, nil
 	}
@@ -1168,11 +1770,22 @@ func (c *DokployClient) GetCompose(id string) (*Compose, error) {
 }
 
 func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
+	defer c.lockTarget("compose", comp.ID, lockFamilyConfig)()
+
 	payload := map[string]interface{}{
-		"composeId":  comp.ID,
-		"name":       comp.Name,
-		"sourceType": comp.SourceType,
-		"autoDeploy": comp.AutoDeploy,
+		"composeId":          comp.ID,
+		"name":               comp.Name,
+		"sourceType":         comp.SourceType,
+		"autoDeploy":         comp.AutoDeploy,
+		"isolatedDeployment": comp.IsolatedDeployment,
+		"randomize":          comp.Randomize,
+	}
+
+	if comp.AppName != "" {
+		payload["appName"] = comp.AppName
+	}
+	if comp.ComposeType != "" {
+		payload["composeType"] = comp.ComposeType
 	}
 
 	if comp.CustomGitUrl != "" {
@@ -1567,6 +2180,44 @@ func (c *DokployClient) DeleteDatabaseWithType(id, dbType string) error {
 	return err
 }
 
+// RestartDatabase restarts a database service. Dokploy's reload endpoints
+// need the service appName, so the database is looked up first.
+func (c *DokployClient) RestartDatabase(id, dbType string) error {
+	var endpoint string
+	var idKey string
+	switch dbType {
+	case "postgres":
+		endpoint = "postgres.reload"
+		idKey = "postgresId"
+	case "mysql":
+		endpoint = "mysql.reload"
+		idKey = "mysqlId"
+	case "mariadb":
+		endpoint = "mariadb.reload"
+		idKey = "mariadbId"
+	case "mongo":
+		endpoint = "mongo.reload"
+		idKey = "mongoId"
+	case "redis":
+		endpoint = "redis.reload"
+		idKey = "redisId"
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+
+	db, err := c.GetDatabase(id, dbType)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]string{
+		idKey:     id,
+		"appName": db.AppName,
+	}
+	_, err = c.doRequest("POST", endpoint, payload)
+	return err
+}
+
 // --- Domain ---
 
 type Domain struct {
@@ -1581,7 +2232,18 @@ type Domain struct {
 	CertificateType string `json:"certificateType"`
 }
 
+// lockDomainParent serializes domain mutations of the application or compose
+// stack that owns domain.
+func (c *DokployClient) lockDomainParent(domain Domain) func() {
+	if domain.ApplicationID != "" {
+		return c.lockTarget("application", domain.ApplicationID, lockFamilyDomains)
+	}
+	return c.lockTarget("compose", domain.ComposeID, lockFamilyDomains)
+}
+
 func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
+	defer c.lockDomainParent(domain)()
+
 	payload := map[string]interface{}{
 		"host":            domain.Host,
 		"path":            domain.Path,
@@ -1634,9 +2296,13 @@ func (c *DokployClient) GetDomainsByCompose(composeID string) ([]Domain, error)
 	return comp.Domains, nil
 }
 
-func (c *DokployClient) DeleteDomain(id string) error {
+// DeleteDomain removes a domain. The domain's application or compose ID
+// selects the lock shared with CreateDomain; only ID is sent to Dokploy.
+func (c *DokployClient) DeleteDomain(domain Domain) error {
+	defer c.lockDomainParent(domain)()
+
 	payload := map[string]string{
-		"domainId": id,
+		"domainId": domain.ID,
 	}
 	_, err := c.doRequest("POST", "domain.remove", payload)
 	return err
@@ -1665,6 +2331,8 @@ func (c *DokployClient) GenerateDomain(appName string) (string, error) {
 }
 
 func (c *DokployClient) UpdateDomain(domain Domain) (*Domain, error) {
+	defer c.lockDomainParent(domain)()
+
 	payload := map[string]interface{}{
 		"domainId":        domain.ID,
 		"host":            domain.Host,
@@ -1705,6 +2373,8 @@ type Port struct {
 }
 
 func (c *DokployClient) CreatePort(port Port) (*Port, error) {
+	defer c.lockTarget("application", port.ApplicationID, lockFamilyPorts)()
+
 	payload := map[string]interface{}{
 		"applicationId": port.ApplicationID,
 		"publishedPort": port.PublishedPort,
@@ -1796,6 +2466,8 @@ func (c *DokployClient) GetPort(id string) (*Port, error) {
 }
 
 func (c *DokployClient) UpdatePort(port Port) (*Port, error) {
+	defer c.lockTarget("application", port.ApplicationID, lockFamilyPorts)()
+
 	payload := map[string]interface{}{
 		"portId":        port.ID,
 		"publishedPort": port.PublishedPort,
@@ -1832,9 +2504,13 @@ func (c *DokployClient) UpdatePort(port Port) (*Port, error) {
 	return c.GetPort(port.ID)
 }
 
-func (c *DokployClient) DeletePort(id string) error {
+// DeletePort removes a port. The port's application ID selects the lock
+// shared with CreatePort; only ID is sent to Dokploy.
+func (c *DokployClient) DeletePort(port Port) error {
+	defer c.lockTarget("application", port.ApplicationID, lockFamilyPorts)()
+
 	payload := map[string]string{
-		"portId": id,
+		"portId": port.ID,
 	}
 	_, err := c.doRequest("POST", "port.delete", payload)
 	if err != nil {
@@ -1854,8 +2530,12 @@ type EnvironmentVariable struct {
 }
 
 func (c *DokployClient) UpdateApplicationEnv(appID string, updateFn func(envMap map[string]string), createEnvFile *bool) error {
+	defer c.lockTarget("application", appID, lockFamilyEnv)()
+
 	var lastErr error
-	for i := 0; i < 5; i++ { // Retry up to 5 times
+	// The target lock serializes writers in this process; retries only cover
+	// concurrent edits made outside of it (UI, other Terraform runs).
+	for i := 0; i < 5; i++ {
 		app, err := c.GetApplication(appID)
 		if err != nil {
 			return err
@@ -1872,9 +2552,13 @@ func (c *DokployClient) UpdateApplicationEnv(appID string, updateFn func(envMap
 			return nil // No changes to be made
 		}
 
+		// Echo the current build arguments and secrets back so saving runtime env
+		// never clobbers them; application.saveEnvironment writes all three.
 		payload := map[string]interface{}{
 			"applicationId": appID,
 			"env":           newEnvStr,
+			"buildArgs":     app.BuildArgs,
+			"buildSecrets":  app.BuildSecrets,
 		}
 		if createEnvFile != nil {
 			payload["createEnvFile"] = *createEnvFile
@@ -1903,7 +2587,64 @@ func (c *DokployClient) UpdateApplicationEnv(appID string, updateFn func(envMap
 	return lastErr
 }
 
+// SaveApplicationBuildEnv replaces the build arguments and build secrets of an
+// application through application.saveEnvironment. A nil map leaves the
+// corresponding value untouched; the runtime env is always preserved.
+func (c *DokployClient) SaveApplicationBuildEnv(appID string, buildArgs, buildSecrets map[string]string) error {
+	defer c.lockTarget("application", appID, lockFamilyEnv)()
+
+	var lastErr error
+	for i := 0; i < 5; i++ { // Retry up to 5 times
+		app, err := c.GetApplication(appID)
+		if err != nil {
+			return err
+		}
+
+		newBuildArgs := app.BuildArgs
+		if buildArgs != nil {
+			newBuildArgs = formatEnv(buildArgs)
+		}
+		newBuildSecrets := app.BuildSecrets
+		if buildSecrets != nil {
+			newBuildSecrets = formatEnv(buildSecrets)
+		}
+
+		if envStringsEqual(newBuildArgs, app.BuildArgs) && envStringsEqual(newBuildSecrets, app.BuildSecrets) {
+			return nil // No changes to be made
+		}
+
+		payload := map[string]interface{}{
+			"applicationId": appID,
+			"env":           app.Env,
+			"buildArgs":     newBuildArgs,
+			"buildSecrets":  newBuildSecrets,
+		}
+
+		_, err = c.doRequest("POST", "application.saveEnvironment", payload)
+		if err != nil {
+			lastErr = err
+			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond) // Backoff
+			continue
+		}
+
+		// Verify write
+		verifyApp, err := c.GetApplication(appID)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to verify build environment update: %w", err)
+			time.Sleep(time.Duration(100*(i+1)) * time.Millisecond)
+			continue
+		}
+		if envStringsEqual(verifyApp.BuildArgs, newBuildArgs) && envStringsEqual(verifyApp.BuildSecrets, newBuildSecrets) {
+			return nil // Success
+		}
+		lastErr = fmt.Errorf("build environment update conflict, retrying")
+	}
+	return lastErr
+}
+
 func (c *DokployClient) UpdateComposeEnv(composeID string, updateFn func(envMap map[string]string), _ *bool) error {
+	defer c.lockTarget("compose", composeID, lockFamilyEnv)()
+
 	var lastErr error
 	for i := 0; i < 5; i++ { // Retry up to 5 times
 		comp, err := c.GetCompose(composeID)
@@ -2018,6 +2759,22 @@ func ParseEnv(env string) map[string]string {
 	return m
 }
 
+// envStringsEqual compares two env-formatted strings by their parsed key/value
+// pairs, ignoring ordering, blank lines and comments.
+func envStringsEqual(a, b string) bool {
+	left := ParseEnv(a)
+	right := ParseEnv(b)
+	if len(left) != len(right) {
+		return false
+	}
+	for k, v := range left {
+		if rv, ok := right[k]; !ok || rv != v {
+			return false
+		}
+	}
+	return true
+}
+
 func formatEnv(m map[string]string) string {
 	var lines []string
 	for k, v := range m {
@@ -2,6 +2,7 @@ package client
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -114,6 +115,51 @@ func TestUpdateTraefikConfig_SendsExpectedPayload(t *testing.T) {
 	}
 }
 
+func TestReadApplicationTraefikConfig_ParsesWrappedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/application.readTraefikConfig" || r.URL.Query().Get("applicationId") != "app-1" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"traefikConfig":"http:\n  routers: {}\n"}`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	config, err := c.ReadApplicationTraefikConfig("app-1")
+	if err != nil {
+		t.Fatalf("ReadApplicationTraefikConfig returned error: %v", err)
+	}
+	if config != "http:\n  routers: {}\n" {
+		t.Fatalf("unexpected config: %q", config)
+	}
+}
+
+func TestUpdateApplicationTraefikConfig_SendsExpectedPayload(t *testing.T) {
+	expectedConfig := "http:\n  middlewares:\n    sticky: {}\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/application.updateTraefikConfig" || r.Method != http.MethodPost {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload["applicationId"] != "app-1" || payload["traefikConfig"] != expectedConfig {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.UpdateApplicationTraefikConfig("app-1", expectedConfig); err != nil {
+		t.Fatalf("UpdateApplicationTraefikConfig returned error: %v", err)
+	}
+}
+
 func TestReadWebServerTraefikConfig_UsesScopedEndpointAndKey(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/settings.readWebServerTraefikConfig" {
@@ -467,6 +513,196 @@ func TestUpdateApplication_SendsExplicitFalseAndZeroPreviewValues(t *testing.T)
 	}
 }
 
+func TestUpdateApplication_SendsResourceSettings(t *testing.T) {
+	var updatePayload map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.update":
+			if err := json.NewDecoder(r.Body).Decode(&updatePayload); err != nil {
+				t.Fatalf("failed to decode application.update payload: %v", err)
+			}
+			w.WriteHeader(http.StatusOK)
+			// Older Dokploy versions report limits as numbers.
+			_, _ = w.Write([]byte(`{"applicationId":"app-123","memoryLimit":536870912,"cpuLimit":"500000000","cpuReservation":null,"replicas":2,"command":"npm start"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	app, err := c.UpdateApplication(Application{
+		ID:          "app-123",
+		Name:        "rssmate",
+		MemoryLimit: "536870912",
+		CPULimit:    "500000000",
+		Replicas:    int64Pointer(2),
+		Command:     "npm start",
+	})
+	if err != nil {
+		t.Fatalf("UpdateApplication returned error: %v", err)
+	}
+
+	if updatePayload["memoryLimit"] != "536870912" || updatePayload["cpuLimit"] != "500000000" {
+		t.Fatalf("unexpected limits in payload: %#v", updatePayload)
+	}
+	if value, ok := updatePayload["memoryReservation"]; !ok || value != nil {
+		t.Fatalf("unset memoryReservation should be cleared: %#v", updatePayload)
+	}
+	if updatePayload["replicas"] != float64(2) || updatePayload["command"] != "npm start" {
+		t.Fatalf("unexpected replicas/command in payload: %#v", updatePayload)
+	}
+	if app.MemoryLimit != "536870912" || app.CPULimit != "500000000" || app.CPUReservation != "" {
+		t.Fatalf("unexpected parsed limits: %#v", app)
+	}
+}
+
+func TestUpdateApplication_ResetsUnsetSettings(t *testing.T) {
+	var updatePayload map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.update":
+			if err := json.NewDecoder(r.Body).Decode(&updatePayload); err != nil {
+				t.Fatalf("failed to decode application.update payload: %v", err)
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"applicationId":"app-123"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	_, err := c.UpdateApplication(Application{
+		ID:              "app-123",
+		Name:            "rssmate",
+		CustomGitBranch: "main",
+		PreviewPath:     "/preview",
+	})
+	if err != nil {
+		t.Fatalf("UpdateApplication returned error: %v", err)
+	}
+
+	for _, key := range []string{"customGitUrl", "username", "labelsSwarm", "previewWildcard", "previewLabels", "rollbackRegistryId", "command", "healthCheckSwarm", "networkSwarm"} {
+		if value, ok := updatePayload[key]; !ok || value != nil {
+			t.Fatalf("expected %s to be cleared with null, got payload %#v", key, updatePayload)
+		}
+	}
+	if updatePayload["previewPort"] != float64(3000) || updatePayload["previewCertificateType"] != "none" || updatePayload["replicas"] != float64(1) {
+		t.Fatalf("expected defaulted settings to be reset, got %#v", updatePayload)
+	}
+	if updatePayload["isPreviewDeploymentsActive"] != false || updatePayload["previewRequireCollaboratorPermissions"] != true {
+		t.Fatalf("expected preview flags to be reset, got %#v", updatePayload)
+	}
+	if updatePayload["customGitBranch"] != "main" || updatePayload["previewPath"] != "/preview" {
+		t.Fatalf("configured settings must still be sent, got %#v", updatePayload)
+	}
+}
+
+func TestSaveDockerProvider_SendsImageAndCredentials(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.saveDockerProvider":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.SaveDockerProvider("app-123", DockerProvider{
+		DockerImage: "ghcr.io/acme/api:1.2.3",
+		Username:    "acme-bot",
+		Password:    "ghp_secret",
+	})
+	if err != nil {
+		t.Fatalf("SaveDockerProvider returned error: %v", err)
+	}
+
+	if payload["applicationId"] != "app-123" || payload["dockerImage"] != "ghcr.io/acme/api:1.2.3" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+	if payload["username"] != "acme-bot" || payload["password"] != "ghp_secret" {
+		t.Fatalf("unexpected credentials: %#v", payload)
+	}
+	if value, ok := payload["registryUrl"]; !ok || value != nil {
+		t.Fatalf("expected an unset registryUrl to be sent as null, got %#v", payload["registryUrl"])
+	}
+}
+
+func TestCreateApplication_InfersDockerSourceType(t *testing.T) {
+	var updatePayload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.create":
+			_, _ = w.Write([]byte(`{"applicationId":"app-123"}`))
+		case "/application.update":
+			if err := json.NewDecoder(r.Body).Decode(&updatePayload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"applicationId":"app-123","sourceType":"docker"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if _, err := c.CreateApplication(Application{Name: "api", EnvironmentID: "env-1", DockerImage: "nginx:1.27"}); err != nil {
+		t.Fatalf("CreateApplication returned error: %v", err)
+	}
+	if updatePayload["sourceType"] != "docker" {
+		t.Fatalf("expected docker sourceType, got %#v", updatePayload["sourceType"])
+	}
+}
+
+func TestDropDeployApplication_ReplaysMultipartBodyOnRetry(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/application.dropDeployment" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("failed to parse multipart body on call %d: %v", calls, err)
+		}
+		if got := r.FormValue("applicationId"); got != "app-123" {
+			t.Fatalf("unexpected applicationId: %q", got)
+		}
+		file, header, err := r.FormFile("zip")
+		if err != nil {
+			t.Fatalf("missing zip part: %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		if string(content) != "zip-bytes" || header.Header.Get("Content-Type") != "application/zip" {
+			t.Fatalf("unexpected zip part: %q (%s)", content, header.Header.Get("Content-Type"))
+		}
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.DropDeployApplication("app-123", []byte("zip-bytes")); err != nil {
+		t.Fatalf("DropDeployApplication returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the upload to be retried once, got %d calls", calls)
+	}
+}
+
 func TestCreateMount_UsesMountsCreateEndpointAndPayload(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -581,6 +817,163 @@ func TestCreateMount_BooleanResponseWithEmptyLookupDoesNotFail(t *testing.T) {
 	}
 }
 
+func TestCreateMount_FileMountOnCompose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mounts.create":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+
+			if payload["serviceType"] != "compose" || payload["serviceId"] != "compose-123" {
+				t.Fatalf("unexpected target: %#v", payload)
+			}
+			if payload["type"] != "file" {
+				t.Fatalf("unexpected type: %#v", payload["type"])
+			}
+			if payload["content"] != "key=value" || payload["filePath"] != "app.conf" {
+				t.Fatalf("unexpected file payload: %#v", payload)
+			}
+			if _, ok := payload["volumeName"]; ok {
+				t.Fatalf("volumeName should not be sent for file mounts: %#v", payload)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"mountId":"mount-456","composeId":"compose-123","type":"file","mountPath":"/etc/app.conf","content":"key=value","filePath":"app.conf"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	mount, err := c.CreateMount(Mount{
+		ServiceType: "compose",
+		ServiceID:   "compose-123",
+		Type:        "file",
+		MountPath:   "/etc/app.conf",
+		Content:     "key=value",
+		FilePath:    "app.conf",
+	})
+	if err != nil {
+		t.Fatalf("CreateMount returned error: %v", err)
+	}
+	if mount.ID != "mount-456" {
+		t.Fatalf("unexpected mount ID: got %q want %q", mount.ID, "mount-456")
+	}
+	if serviceType, serviceID := mount.Target(); serviceType != "compose" || serviceID != "compose-123" {
+		t.Fatalf("unexpected target: got (%q, %q)", serviceType, serviceID)
+	}
+}
+
+func TestCreateMount_RejectsUnknownServiceType(t *testing.T) {
+	c := NewDokployClient("http://127.0.0.1:0", "test-key")
+	if _, err := c.CreateMount(Mount{ServiceType: "cluster", ServiceID: "x", MountPath: "/data"}); err == nil {
+		t.Fatal("expected CreateMount to reject an unknown service type")
+	}
+}
+
+func TestUpdateMount_UsesUpdateEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mounts.update":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			if payload["mountId"] != "mount-123" {
+				t.Fatalf("unexpected mountId: %#v", payload["mountId"])
+			}
+			if payload["type"] != "bind" || payload["hostPath"] != "/srv/data" || payload["mountPath"] != "/data" {
+				t.Fatalf("unexpected payload: %#v", payload)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/mounts.one":
+			if got := r.URL.Query().Get("mountId"); got != "mount-123" {
+				t.Fatalf("unexpected mountId query: %q", got)
+			}
+			_, _ = w.Write([]byte(`{"mountId":"mount-123","postgresId":"pg-1","type":"bind","mountPath":"/data","hostPath":"/srv/data"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	mount, err := c.UpdateMount(Mount{
+		ID:          "mount-123",
+		ServiceType: "postgres",
+		ServiceID:   "pg-1",
+		Type:        "bind",
+		MountPath:   "/data",
+		HostPath:    "/srv/data",
+	})
+	if err != nil {
+		t.Fatalf("UpdateMount returned error: %v", err)
+	}
+	if mount.HostPath != "/srv/data" {
+		t.Fatalf("unexpected host path: got %q", mount.HostPath)
+	}
+}
+
+func TestListMounts_ReadsDatabaseMounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redis.one":
+			if got := r.URL.Query().Get("redisId"); got != "redis-1" {
+				t.Fatalf("unexpected redisId query: %q", got)
+			}
+			_, _ = w.Write([]byte(`{"redisId":"redis-1","mounts":[{"mountId":"mount-1","redisId":"redis-1","type":"volume","mountPath":"/data","volumeName":"redis-data"}]}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	mounts, err := c.ListMounts("redis", "redis-1")
+	if err != nil {
+		t.Fatalf("ListMounts returned error: %v", err)
+	}
+	if len(mounts) != 1 || mounts[0].ID != "mount-1" || mounts[0].VolumeName != "redis-data" {
+		t.Fatalf("unexpected mounts: %#v", mounts)
+	}
+}
+
+func TestListMounts_FailsOnResponseWithoutMounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "empty mounts", body: `{"composeId":"comp-1","mounts":[]}`},
+		{name: "wrapped empty mounts", body: `{"compose":{"composeId":"comp-1","mounts":[]}}`},
+		{name: "missing mounts", body: `{"composeId":"comp-1"}`, wantErr: true},
+		{name: "html page", body: `<html>Bad Gateway</html>`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			c := NewDokployClient(server.URL, "test-key")
+			mounts, err := c.ListMounts("compose", "comp-1")
+			if !test.wantErr {
+				if err != nil || mounts == nil || len(mounts) != 0 {
+					t.Fatalf("expected no mounts, got %#v, %v", mounts, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.body) {
+				t.Fatalf("expected a parse error with the response body, got %#v, %v", mounts, err)
+			}
+		})
+	}
+}
+
 func TestDeleteMount_UsesRemoveEndpoint(t *testing.T) {
 	var calls []string
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -596,7 +989,7 @@ func TestDeleteMount_UsesRemoveEndpoint(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.DeleteMount("mount-123"); err != nil {
+	if err := c.DeleteMount(Mount{ID: "mount-123", ApplicationID: "app-123"}); err != nil {
 		t.Fatalf("DeleteMount returned error: %v", err)
 	}
 
@@ -624,7 +1017,7 @@ func TestDeleteMount_FallsBackToLegacyEndpoint(t *testing.T) {
 	defer server.Close()
 
 	c := NewDokployClient(server.URL, "test-key")
-	if err := c.DeleteMount("mount-123"); err != nil {
+	if err := c.DeleteMount(Mount{ID: "mount-123", ApplicationID: "app-123"}); err != nil {
 		t.Fatalf("DeleteMount returned error: %v", err)
 	}
 
@@ -726,6 +1119,94 @@ func TestUpdateProjectEnv_NoChangesSkipsUpdate(t *testing.T) {
 	}
 }
 
+func TestSaveApplicationBuildEnv_PreservesRuntimeEnv(t *testing.T) {
+	appEnv := "PORT=3000"
+	buildArgs := "VERSION=1.0.0"
+	buildSecrets := ""
+	var savePayload map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.one":
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"applicationId": "app-123",
+				"env":           appEnv,
+				"buildArgs":     buildArgs,
+				"buildSecrets":  buildSecrets,
+			})
+		case "/application.saveEnvironment":
+			if err := json.NewDecoder(r.Body).Decode(&savePayload); err != nil {
+				t.Fatalf("failed to decode application.saveEnvironment payload: %v", err)
+			}
+			buildArgs, _ = savePayload["buildArgs"].(string)
+			buildSecrets, _ = savePayload["buildSecrets"].(string)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.SaveApplicationBuildEnv("app-123", nil, map[string]string{"NPM_TOKEN": "secret"})
+	if err != nil {
+		t.Fatalf("SaveApplicationBuildEnv returned error: %v", err)
+	}
+
+	if savePayload["env"] != "PORT=3000" {
+		t.Fatalf("expected runtime env to be preserved, got: %#v", savePayload["env"])
+	}
+	if savePayload["buildArgs"] != "VERSION=1.0.0" {
+		t.Fatalf("expected untouched buildArgs to be preserved, got: %#v", savePayload["buildArgs"])
+	}
+	if savePayload["buildSecrets"] != "NPM_TOKEN=secret" {
+		t.Fatalf("unexpected buildSecrets in payload: %#v", savePayload["buildSecrets"])
+	}
+}
+
+func TestUpdateApplicationEnv_PreservesBuildArgs(t *testing.T) {
+	appEnv := "A=1"
+	var savePayload map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.one":
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"applicationId": "app-123",
+				"env":           appEnv,
+				"buildArgs":     "VERSION=1.0.0",
+				"buildSecrets":  "NPM_TOKEN=secret",
+			})
+		case "/application.saveEnvironment":
+			if err := json.NewDecoder(r.Body).Decode(&savePayload); err != nil {
+				t.Fatalf("failed to decode application.saveEnvironment payload: %v", err)
+			}
+			appEnv, _ = savePayload["env"].(string)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.UpdateApplicationEnv("app-123", func(envMap map[string]string) {
+		envMap["A"] = "2"
+	}, nil)
+	if err != nil {
+		t.Fatalf("UpdateApplicationEnv returned error: %v", err)
+	}
+
+	if savePayload["buildArgs"] != "VERSION=1.0.0" {
+		t.Fatalf("expected buildArgs to be preserved, got: %#v", savePayload["buildArgs"])
+	}
+	if savePayload["buildSecrets"] != "NPM_TOKEN=secret" {
+		t.Fatalf("expected buildSecrets to be preserved, got: %#v", savePayload["buildSecrets"])
+	}
+}
+
 func TestCreateDatabase_MySQLDirectResponseUsesMysqlIDAsID(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -778,6 +1259,32 @@ func TestCreateDatabase_MySQLWrappedResponseUsesMysqlIDAsID(t *testing.T) {
 	}
 }
 
+func TestRestartDatabase_SendsAppNameToReloadEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/postgres.one":
+			_, _ = w.Write([]byte(`{"postgresId":"pg-123","name":"db","appName":"db-abc123"}`))
+		case "/postgres.reload":
+			var payload map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			if payload["postgresId"] != "pg-123" || payload["appName"] != "db-abc123" {
+				t.Fatalf("unexpected reload payload: %#v", payload)
+			}
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.RestartDatabase("pg-123", "postgres"); err != nil {
+		t.Fatalf("RestartDatabase returned error: %v", err)
+	}
+}
+
 func TestCreateVolumeBackup_UsesComposeEndpointAndPayload(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -1024,3 +1531,415 @@ func TestUpdateBackupDestination_FallsBackToGetDestination(t *testing.T) {
 		t.Fatalf("unexpected destination ID: got %q want %q", destination.ID, "dest-123")
 	}
 }
+
+func TestSaveApplicationGitProvider_UsesGitlabEndpoint(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.saveGitlabProvider":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	projectID := int64(42)
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.SaveApplicationGitProvider("app-123", GitProviderSource{
+		Provider:   "gitlab",
+		ProviderID: "gitlab-1",
+		Repository: "api",
+		Owner:      "platform/backend",
+		Branch:     "main",
+		WatchPaths: []string{"services/api/**"},
+		ProjectID:  &projectID,
+	})
+	if err != nil {
+		t.Fatalf("SaveApplicationGitProvider returned error: %v", err)
+	}
+
+	if payload["applicationId"] != "app-123" || payload["gitlabId"] != "gitlab-1" || payload["gitlabRepository"] != "api" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+	if payload["gitlabPathNamespace"] != "platform/backend/api" || payload["gitlabProjectId"] != float64(42) {
+		t.Fatalf("unexpected gitlab project fields: %#v", payload)
+	}
+	if payload["gitlabBuildPath"] != "/" {
+		t.Fatalf("expected build path to default to /, got %#v", payload["gitlabBuildPath"])
+	}
+	if paths, ok := payload["watchPaths"].([]interface{}); !ok || len(paths) != 1 || paths[0] != "services/api/**" {
+		t.Fatalf("unexpected watchPaths: %#v", payload["watchPaths"])
+	}
+}
+
+func TestCreateCompose_SendsStackSettingsAndExplicitAppName(t *testing.T) {
+	var createPayload, updatePayload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.create":
+			if err := json.NewDecoder(r.Body).Decode(&createPayload); err != nil {
+				t.Fatalf("failed to decode create payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-123","appName":"monitoring-x1y2z3"}`))
+		case "/compose.update":
+			if err := json.NewDecoder(r.Body).Decode(&updatePayload); err != nil {
+				t.Fatalf("failed to decode update payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-123","appName":"monitoring","composeType":"stack","isolatedDeployment":true,"randomize":true}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	comp, err := c.CreateCompose(Compose{
+		Name:               "Monitoring",
+		AppName:            "monitoring",
+		EnvironmentID:      "env-1",
+		ComposeFile:        "services: {}",
+		SourceType:         "raw",
+		ComposeType:        "stack",
+		IsolatedDeployment: true,
+		Randomize:          true,
+	})
+	if err != nil {
+		t.Fatalf("CreateCompose returned error: %v", err)
+	}
+
+	if createPayload["composeType"] != "stack" || createPayload["appName"] != "monitoring" {
+		t.Fatalf("unexpected create payload: %#v", createPayload)
+	}
+	if updatePayload["appName"] != "monitoring" || updatePayload["isolatedDeployment"] != true || updatePayload["randomize"] != true {
+		t.Fatalf("unexpected update payload: %#v", updatePayload)
+	}
+	if comp.AppName != "monitoring" || comp.ComposeType != "stack" || !comp.IsolatedDeployment || !comp.Randomize {
+		t.Fatalf("unexpected compose: %#v", comp)
+	}
+}
+
+func TestCreateCompose_UpdatesSettingsWhenCreateReturnsWrappedCompose(t *testing.T) {
+	var updatePayload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.create":
+			_, _ = w.Write([]byte(`{"compose":{"composeId":"comp-123","appName":"monitoring-x1y2z3"}}`))
+		case "/compose.update":
+			if err := json.NewDecoder(r.Body).Decode(&updatePayload); err != nil {
+				t.Fatalf("failed to decode update payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-123","appName":"monitoring","isolatedDeployment":true,"randomize":true}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	comp, err := c.CreateCompose(Compose{
+		Name:               "Monitoring",
+		AppName:            "monitoring",
+		EnvironmentID:      "env-1",
+		SourceType:         "raw",
+		IsolatedDeployment: true,
+		Randomize:          true,
+	})
+	if err != nil {
+		t.Fatalf("CreateCompose returned error: %v", err)
+	}
+
+	if updatePayload == nil {
+		t.Fatal("expected compose.update to be called")
+	}
+	if updatePayload["composeId"] != "comp-123" || updatePayload["appName"] != "monitoring" || updatePayload["isolatedDeployment"] != true || updatePayload["randomize"] != true {
+		t.Fatalf("unexpected update payload: %#v", updatePayload)
+	}
+	if comp.AppName != "monitoring" || !comp.IsolatedDeployment || !comp.Randomize {
+		t.Fatalf("unexpected compose: %#v", comp)
+	}
+}
+
+func TestCreateCompose_DefaultsComposeTypeAndAppName(t *testing.T) {
+	var createPayload, updatePayload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.create":
+			if err := json.NewDecoder(r.Body).Decode(&createPayload); err != nil {
+				t.Fatalf("failed to decode create payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-123","appName":"monitoring-x1y2z3"}`))
+		case "/compose.update":
+			if err := json.NewDecoder(r.Body).Decode(&updatePayload); err != nil {
+				t.Fatalf("failed to decode update payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-123","appName":"monitoring-x1y2z3"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if _, err := c.CreateCompose(Compose{Name: "monitoring", EnvironmentID: "env-1", SourceType: "raw"}); err != nil {
+		t.Fatalf("CreateCompose returned error: %v", err)
+	}
+
+	if createPayload["composeType"] != "docker-compose" || createPayload["appName"] != "monitoring" {
+		t.Fatalf("unexpected create payload: %#v", createPayload)
+	}
+	if _, ok := updatePayload["appName"]; ok {
+		t.Fatalf("generated appName must not be overwritten: %#v", updatePayload)
+	}
+}
+
+func TestUpdateCompose_SendsStackSettings(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.update":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-123","appName":"monitoring-x1y2z3"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	_, err := c.UpdateCompose(Compose{
+		ID:          "comp-123",
+		Name:        "monitoring",
+		AppName:     "monitoring-x1y2z3",
+		ComposeType: "docker-compose",
+	})
+	if err != nil {
+		t.Fatalf("UpdateCompose returned error: %v", err)
+	}
+
+	if payload["composeType"] != "docker-compose" || payload["appName"] != "monitoring-x1y2z3" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+	if payload["isolatedDeployment"] != false || payload["randomize"] != false {
+		t.Fatalf("disabled settings must be sent explicitly: %#v", payload)
+	}
+}
+
+func TestSaveComposeGitProvider_SendsSourceTypeThroughUpdate(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.update":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-123","sourceType":"gitea"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.SaveComposeGitProvider("comp-123", GitProviderSource{
+		Provider:   "gitea",
+		ProviderID: "gitea-1",
+		Repository: "stack",
+		Owner:      "ops",
+		Branch:     "main",
+	})
+	if err != nil {
+		t.Fatalf("SaveComposeGitProvider returned error: %v", err)
+	}
+
+	if payload["composeId"] != "comp-123" || payload["sourceType"] != "gitea" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+	if payload["giteaId"] != "gitea-1" || payload["giteaRepository"] != "stack" || payload["giteaOwner"] != "ops" || payload["giteaBranch"] != "main" {
+		t.Fatalf("unexpected gitea fields: %#v", payload)
+	}
+	if _, ok := payload["giteaBuildPath"]; ok {
+		t.Fatalf("compose payload must not include a build path: %#v", payload)
+	}
+}
+
+func TestSaveApplicationGitProvider_RejectsUnknownProvider(t *testing.T) {
+	c := NewDokployClient("http://127.0.0.1:1", "test-key")
+	if err := c.SaveApplicationGitProvider("app-123", GitProviderSource{Provider: "svn"}); err == nil {
+		t.Fatal("expected an error for an unsupported provider")
+	}
+}
+
+func TestApplicationGitProviderSource_ReadsPrefixedFields(t *testing.T) {
+	var app Application
+	body := `{"applicationId":"app-123","sourceType":"bitbucket","bitbucketId":"bb-1","bitbucketRepository":"web","bitbucketOwner":"acme","bitbucketBranch":"develop","bitbucketBuildPath":"/web","watchPaths":["web/**"]}`
+	if err := json.Unmarshal([]byte(body), &app); err != nil {
+		t.Fatalf("failed to decode application: %v", err)
+	}
+
+	source := app.GitProviderSource("bitbucket")
+	if source.ProviderID != "bb-1" || source.Repository != "web" || source.Owner != "acme" || source.Branch != "develop" || source.BuildPath != "/web" {
+		t.Fatalf("unexpected source: %#v", source)
+	}
+	if len(source.WatchPaths) != 1 || source.WatchPaths[0] != "web/**" {
+		t.Fatalf("unexpected watch paths: %#v", source.WatchPaths)
+	}
+}
+
+func TestCreateGitlabProvider_LooksUpCreatedProviderByName(t *testing.T) {
+	var createPayload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gitlab.create":
+			if err := json.NewDecoder(r.Body).Decode(&createPayload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/gitProvider.getAll":
+			_, _ = w.Write([]byte(`[
+				{"gitProviderId":"gp-old","name":"self-hosted","providerType":"gitlab","createdAt":"2024-01-01T00:00:00Z","gitlab":{"gitlabId":"gl-old"}},
+				{"gitProviderId":"gp-new","name":"self-hosted","providerType":"gitlab","createdAt":"2024-06-01T00:00:00Z","gitlab":{"gitlabId":"gl-new"}},
+				{"gitProviderId":"gp-gitea","name":"self-hosted","providerType":"gitea","createdAt":"2024-07-01T00:00:00Z","gitea":{"giteaId":"gt-1"}}
+			]`))
+		case "/gitlab.one":
+			if got := r.URL.Query().Get("gitlabId"); got != "gl-new" {
+				t.Fatalf("expected the newest gitlab provider to be read, got %q", got)
+			}
+			_, _ = w.Write([]byte(`{"gitlabId":"gl-new","gitProviderId":"gp-new","gitlabUrl":"https://gitlab.example.com","groupName":"platform","gitProvider":{"name":"self-hosted"}}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	created, err := c.CreateGitlabProvider(GitlabProvider{
+		Name:        "self-hosted",
+		GitlabURL:   "https://gitlab.example.com",
+		GroupName:   "platform",
+		AccessToken: "glpat-secret",
+	})
+	if err != nil {
+		t.Fatalf("CreateGitlabProvider returned error: %v", err)
+	}
+
+	if createPayload["accessToken"] != "glpat-secret" || createPayload["gitlabUrl"] != "https://gitlab.example.com" {
+		t.Fatalf("unexpected create payload: %#v", createPayload)
+	}
+	if value, ok := createPayload["secret"]; !ok || value != nil {
+		t.Fatalf("expected an unset secret to be sent as null, got %#v", createPayload["secret"])
+	}
+	if created.ID != "gl-new" || created.GitProviderID != "gp-new" || created.Name != "self-hosted" || created.GroupName != "platform" {
+		t.Fatalf("unexpected created provider: %#v", created)
+	}
+}
+
+func TestUpdateBitbucketProvider_SendsIDsAndToken(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bitbucket.update":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/bitbucket.one":
+			_, _ = w.Write([]byte(`{"bitbucketId":"bb-1","gitProviderId":"gp-1","bitbucketUsername":"acme-bot","bitbucketEmail":"bot@acme.dev","gitProvider":{"name":"acme"}}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	updated, err := c.UpdateBitbucketProvider(BitbucketProvider{
+		ID:            "bb-1",
+		GitProviderID: "gp-1",
+		Name:          "acme",
+		Username:      "acme-bot",
+		Email:         "bot@acme.dev",
+		APIToken:      "atlassian-token",
+	})
+	if err != nil {
+		t.Fatalf("UpdateBitbucketProvider returned error: %v", err)
+	}
+
+	if payload["bitbucketId"] != "bb-1" || payload["gitProviderId"] != "gp-1" || payload["apiToken"] != "atlassian-token" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+	if value, ok := payload["appPassword"]; !ok || value != nil {
+		t.Fatalf("expected an unset app password to be sent as null, got %#v", payload["appPassword"])
+	}
+	if updated.Name != "acme" || updated.Email != "bot@acme.dev" {
+		t.Fatalf("unexpected updated provider: %#v", updated)
+	}
+}
+
+func TestDeleteGitProvider_UsesGitProviderID(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gitProvider.remove":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.DeleteGitProvider("gp-1"); err != nil {
+		t.Fatalf("DeleteGitProvider returned error: %v", err)
+	}
+	if payload["gitProviderId"] != "gp-1" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+}
+
+func TestSaveComposeGitProvider_SendsUnprefixedGithubFields(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.update":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	err := c.SaveComposeGitProvider("comp-123", GitProviderSource{
+		Provider:         "github",
+		ProviderID:       "gh-1",
+		Repository:       "stack",
+		Owner:            "acme",
+		Branch:           "main",
+		EnableSubmodules: true,
+		TriggerType:      "tag",
+	})
+	if err != nil {
+		t.Fatalf("SaveComposeGitProvider returned error: %v", err)
+	}
+
+	if payload["sourceType"] != "github" || payload["githubId"] != "gh-1" || payload["triggerType"] != "tag" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+	if payload["repository"] != "stack" || payload["owner"] != "acme" || payload["branch"] != "main" || payload["enableSubmodules"] != true {
+		t.Fatalf("unexpected github fields: %#v", payload)
+	}
+	if _, ok := payload["githubRepository"]; ok {
+		t.Fatalf("github fields must not be prefixed: %#v", payload)
+	}
+}
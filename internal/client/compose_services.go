@@ -0,0 +1,70 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// --- Compose services ---
+
+// Container is a running or stopped container reported by Dokploy's Docker
+// integration.
+type Container struct {
+	ID     string `json:"containerId"`
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Status string `json:"status"`
+}
+
+// LoadComposeServices returns the service names of the compose file Dokploy
+// last fetched for the compose stack.
+func (c *DokployClient) LoadComposeServices(composeID string) ([]string, error) {
+	endpoint := fmt.Sprintf("compose.loadServices?composeId=%s&type=cache", url.QueryEscape(composeID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	var services []string
+	if err := json.Unmarshal(resp, &services); err != nil {
+		return nil, fmt.Errorf("failed to parse compose.loadServices response: %w", err)
+	}
+	return services, nil
+}
+
+// GetConvertedCompose returns the compose file as Dokploy deploys it, with
+// domain labels added and randomized names applied.
+func (c *DokployClient) GetConvertedCompose(composeID string) (string, error) {
+	endpoint := fmt.Sprintf("compose.getConvertedCompose?composeId=%s", url.QueryEscape(composeID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	var content string
+	if err := json.Unmarshal(resp, &content); err != nil {
+		return "", fmt.Errorf("failed to parse compose.getConvertedCompose response: %w", err)
+	}
+	return content, nil
+}
+
+// ListContainersByAppName returns the containers whose name matches appName.
+// appType is docker-compose or stack; serverID is empty for the Dokploy host.
+func (c *DokployClient) ListContainersByAppName(appName, appType, serverID string) ([]Container, error) {
+	query := url.Values{}
+	query.Set("appName", appName)
+	if appType != "" {
+		query.Set("appType", appType)
+	}
+	if serverID != "" {
+		query.Set("serverId", serverID)
+	}
+	resp, err := c.doRequest("GET", "docker.getContainersByAppNameMatch?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var containers []Container
+	if err := json.Unmarshal(resp, &containers); err != nil {
+		return nil, fmt.Errorf("failed to parse docker.getContainersByAppNameMatch response: %w", err)
+	}
+	return containers, nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestLoadComposeServices_UsesCachedFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/compose.loadServices" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("composeId") != "comp-1" || r.URL.Query().Get("type") != "cache" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`["web","db"]`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	services, err := c.LoadComposeServices("comp-1")
+	if err != nil {
+		t.Fatalf("LoadComposeServices returned error: %v", err)
+	}
+	if want := []string{"web", "db"}; !reflect.DeepEqual(services, want) {
+		t.Fatalf("unexpected services: got %v want %v", services, want)
+	}
+}
+
+func TestGetConvertedCompose_DecodesString(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/compose.getConvertedCompose" || r.URL.Query().Get("composeId") != "comp-1" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`"services:\n  web:\n    image: nginx\n"`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	content, err := c.GetConvertedCompose("comp-1")
+	if err != nil {
+		t.Fatalf("GetConvertedCompose returned error: %v", err)
+	}
+	if content != "services:\n  web:\n    image: nginx\n" {
+		t.Fatalf("unexpected content: %q", content)
+	}
+}
+
+func TestListContainersByAppName_SendsAppTypeAndServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/docker.getContainersByAppNameMatch" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("appName") != "shop-abc123" || query.Get("appType") != "stack" || query.Get("serverId") != "srv-1" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`[{"containerId":"c1","name":"shop-abc123_web.1.x1","state":"running","status":"Up 2 minutes"}]`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	containers, err := c.ListContainersByAppName("shop-abc123", "stack", "srv-1")
+	if err != nil {
+		t.Fatalf("ListContainersByAppName returned error: %v", err)
+	}
+	if len(containers) != 1 || containers[0].Name != "shop-abc123_web.1.x1" || containers[0].State != "running" {
+		t.Fatalf("unexpected containers: %+v", containers)
+	}
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// --- Compose templates ---
+
+// ComposeTemplate is an entry of Dokploy's one-click template catalogue.
+type ComposeTemplate struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Version     string   `json:"version"`
+	Logo        string   `json:"logo"`
+	Tags        []string `json:"tags"`
+	Links       struct {
+		Github  string `json:"github"`
+		Website string `json:"website"`
+		Docs    string `json:"docs"`
+	} `json:"links"`
+}
+
+// ComposeTemplateDeployment selects the template to instantiate. ServerID and
+// BaseURL are optional; BaseURL points at an alternative template catalogue.
+type ComposeTemplateDeployment struct {
+	EnvironmentID string
+	TemplateID    string
+	ServerID      string
+	BaseURL       string
+}
+
+// ListComposeTemplates returns the templates of the catalogue at baseURL, or
+// of Dokploy's default catalogue when baseURL is empty.
+func (c *DokployClient) ListComposeTemplates(baseURL string) ([]ComposeTemplate, error) {
+	endpoint := "compose.templates"
+	if baseURL != "" {
+		endpoint += "?baseUrl=" + url.QueryEscape(baseURL)
+	}
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	var templates []ComposeTemplate
+	if err := json.Unmarshal(resp, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse compose.templates response: %w", err)
+	}
+	return templates, nil
+}
+
+// DeployComposeTemplate creates a compose stack from a template. Dokploy
+// generates the compose file, env, domains and file mounts; the stack is not
+// deployed. Older Dokploy versions do not return the new stack, so it is then
+// looked up in the environment. That lookup fails if more than one new stack
+// of the template appeared meanwhile, since the one created here cannot be
+// told apart.
+func (c *DokployClient) DeployComposeTemplate(deployment ComposeTemplateDeployment) (*Compose, error) {
+	existing, err := c.environmentComposeIDs(deployment.EnvironmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list compose stacks of environment %s: %w", deployment.EnvironmentID, err)
+	}
+
+	payload := map[string]interface{}{
+		"environmentId": deployment.EnvironmentID,
+		"id":            deployment.TemplateID,
+	}
+	if deployment.ServerID != "" {
+		payload["serverId"] = deployment.ServerID
+	}
+	if deployment.BaseURL != "" {
+		payload["baseUrl"] = deployment.BaseURL
+	}
+	resp, err := c.doRequest("POST", "compose.deployTemplate", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Compose
+	if err := json.Unmarshal(resp, &created); err == nil && created.ID != "" {
+		return c.GetCompose(created.ID)
+	}
+
+	env, err := c.getEnvironment(deployment.EnvironmentID)
+	if err != nil {
+		return nil, fmt.Errorf("template deployed but the compose stack could not be found: %w", err)
+	}
+	var candidates []string
+	for _, comp := range env.Compose {
+		if existing[comp.ID] || comp.Name != deployment.TemplateID {
+			continue
+		}
+		candidates = append(candidates, comp.ID)
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("template %s deployed but no new compose stack was found in environment %s", deployment.TemplateID, deployment.EnvironmentID)
+	case 1:
+		return c.GetCompose(candidates[0])
+	}
+	return nil, fmt.Errorf("template %s deployed but %d new compose stacks of it were found in environment %s (%s), so the one created here is not known; import the right one and remove the others",
+		deployment.TemplateID, len(candidates), deployment.EnvironmentID, strings.Join(candidates, ", "))
+}
+
+func (c *DokployClient) getEnvironment(environmentID string) (*Environment, error) {
+	endpoint := fmt.Sprintf("environment.one?environmentId=%s", url.QueryEscape(environmentID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	var env Environment
+	if err := json.Unmarshal(resp, &env); err != nil {
+		return nil, fmt.Errorf("failed to parse environment.one response: %w", err)
+	}
+	return &env, nil
+}
+
+func (c *DokployClient) environmentComposeIDs(environmentID string) (map[string]bool, error) {
+	env, err := c.getEnvironment(environmentID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool, len(env.Compose))
+	for _, comp := range env.Compose {
+		ids[comp.ID] = true
+	}
+	return ids, nil
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListComposeTemplates_SendsBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/compose.templates" || r.URL.Query().Get("baseUrl") != "https://templates.example.com" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`[{"id":"plausible","name":"Plausible","version":"v2.1.0","tags":["analytics"],"links":{"github":"https://github.com/plausible/analytics"}}]`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	templates, err := c.ListComposeTemplates("https://templates.example.com")
+	if err != nil {
+		t.Fatalf("ListComposeTemplates returned error: %v", err)
+	}
+	if len(templates) != 1 || templates[0].ID != "plausible" || templates[0].Tags[0] != "analytics" || templates[0].Links.Github == "" {
+		t.Fatalf("unexpected templates: %+v", templates)
+	}
+}
+
+func TestDeployComposeTemplate_UsesReturnedCompose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/environment.one":
+			_, _ = w.Write([]byte(`{"environmentId":"env-1","compose":[]}`))
+		case "/compose.deployTemplate":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			if payload["environmentId"] != "env-1" || payload["id"] != "umami" || payload["serverId"] != "srv-1" {
+				t.Fatalf("unexpected payload: %v", payload)
+			}
+			if _, ok := payload["baseUrl"]; ok {
+				t.Fatalf("baseUrl should be omitted: %v", payload)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-1","name":"umami"}`))
+		case "/compose.one":
+			_, _ = w.Write([]byte(`{"composeId":"comp-1","name":"umami","appName":"prod-umami-x1y2z3","env":"APP_SECRET=abc"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	comp, err := c.DeployComposeTemplate(ComposeTemplateDeployment{EnvironmentID: "env-1", TemplateID: "umami", ServerID: "srv-1"})
+	if err != nil {
+		t.Fatalf("DeployComposeTemplate returned error: %v", err)
+	}
+	if comp.ID != "comp-1" || comp.AppName != "prod-umami-x1y2z3" {
+		t.Fatalf("unexpected compose: %+v", comp)
+	}
+}
+
+func TestDeployComposeTemplate_FindsNewComposeInEnvironment(t *testing.T) {
+	deployed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/environment.one":
+			if !deployed {
+				_, _ = w.Write([]byte(`{"environmentId":"env-1","compose":[{"composeId":"comp-old","name":"umami","createdAt":"2026-01-01T10:00:00.000Z"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"environmentId":"env-1","compose":[
+				{"composeId":"comp-old","name":"umami","createdAt":"2026-01-01T10:00:00.000Z"},
+				{"composeId":"comp-other","name":"n8n","createdAt":"2026-01-02T10:00:00.000Z"},
+				{"composeId":"comp-new","name":"umami","createdAt":"2026-01-02T11:00:00.000Z"}
+			]}`))
+		case "/compose.deployTemplate":
+			deployed = true
+			_, _ = w.Write([]byte(`null`))
+		case "/compose.one":
+			if r.URL.Query().Get("composeId") != "comp-new" {
+				t.Fatalf("unexpected compose lookup: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-new","name":"umami"}`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	comp, err := c.DeployComposeTemplate(ComposeTemplateDeployment{EnvironmentID: "env-1", TemplateID: "umami"})
+	if err != nil {
+		t.Fatalf("DeployComposeTemplate returned error: %v", err)
+	}
+	if comp.ID != "comp-new" {
+		t.Fatalf("unexpected compose: %+v", comp)
+	}
+}
+
+func TestDeployComposeTemplate_FailsOnSeveralNewComposeStacks(t *testing.T) {
+	deployed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/environment.one":
+			if !deployed {
+				_, _ = w.Write([]byte(`{"environmentId":"env-1","compose":[]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"environmentId":"env-1","compose":[
+				{"composeId":"comp-a","name":"umami","createdAt":"2026-01-02T10:00:00.000Z"},
+				{"composeId":"comp-b","name":"umami","createdAt":"2026-01-02T11:00:00.000Z"}
+			]}`))
+		case "/compose.deployTemplate":
+			deployed = true
+			_, _ = w.Write([]byte(`null`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	_, err := c.DeployComposeTemplate(ComposeTemplateDeployment{EnvironmentID: "env-1", TemplateID: "umami"})
+	if err == nil {
+		t.Fatal("expected an error when the new compose stack is ambiguous")
+	}
+	if !strings.Contains(err.Error(), "comp-a") || !strings.Contains(err.Error(), "comp-b") {
+		t.Fatalf("expected the candidates in the error, got %v", err)
+	}
+}
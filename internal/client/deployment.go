@@ -0,0 +1,420 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// --- Deployment ---
+
+type Deployment struct {
+	ID            string `json:"deploymentId"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Status        string `json:"status"`
+	LogPath       string `json:"logPath"`
+	ApplicationID string `json:"applicationId"`
+	ComposeID     string `json:"composeId"`
+	ServerID      string `json:"serverId"`
+	ErrorMessage  string `json:"errorMessage"`
+	CommitHash    string `json:"commitHash"`
+	CreatedAt     string `json:"createdAt"`
+	StartedAt     string `json:"startedAt"`
+	FinishedAt    string `json:"finishedAt"`
+	// Rollback is only present for deployments whose image was pushed to the
+	// application's rollback registry.
+	Rollback   *DeploymentRollback `json:"rollback"`
+	RollbackID string              `json:"rollbackId"`
+}
+
+type DeploymentRollback struct {
+	ID      string `json:"rollbackId"`
+	Image   string `json:"image"`
+	Version int64  `json:"version"`
+}
+
+// RollbackTargetID returns the rollback that restores this deployment's image,
+// or an empty string when the deployment cannot be rolled back to.
+func (d Deployment) RollbackTargetID() string {
+	if d.Rollback != nil && d.Rollback.ID != "" {
+		return d.Rollback.ID
+	}
+	return d.RollbackID
+}
+
+// DeploymentError is returned when a deployment finishes unsuccessfully.
+type DeploymentError struct {
+	Deployment Deployment
+}
+
+func (e *DeploymentError) Error() string {
+	msg := fmt.Sprintf("deployment %s (%q) finished with status %q", e.Deployment.ID, e.Deployment.Title, e.Deployment.Status)
+	if strings.TrimSpace(e.Deployment.ErrorMessage) != "" {
+		msg += ": " + strings.TrimSpace(e.Deployment.ErrorMessage)
+	}
+	return msg
+}
+
+var (
+	// deploymentPollInterval is the delay between deployment status checks.
+	deploymentPollInterval = 5 * time.Second
+	// deploymentLogIdleTimeout ends a log read once the stream goes quiet.
+	deploymentLogIdleTimeout = 2 * time.Second
+	// deploymentLogMaxDuration bounds a single log read.
+	deploymentLogMaxDuration = 30 * time.Second
+)
+
+// maxDeploymentLogBytes caps how much of a build log is buffered in memory.
+const maxDeploymentLogBytes = 4 << 20
+
+func (c *DokployClient) ListApplicationDeployments(appID string) ([]Deployment, error) {
+	endpoint := fmt.Sprintf("deployment.all?applicationId=%s", url.QueryEscape(appID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseDeploymentListResponse(resp)
+}
+
+func (c *DokployClient) ListComposeDeployments(composeID string) ([]Deployment, error) {
+	endpoint := fmt.Sprintf("deployment.allByCompose?composeId=%s", url.QueryEscape(composeID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseDeploymentListResponse(resp)
+}
+
+// LatestApplicationDeploymentID returns the ID of the most recent application deployment,
+// or an empty string when there is none. Errors are treated as "no deployment"
+// because the ID is only used as a baseline for WaitForApplicationDeployment.
+func (c *DokployClient) LatestApplicationDeploymentID(appID string) string {
+	deployments, err := c.ListApplicationDeployments(appID)
+	if err != nil {
+		return ""
+	}
+	if latest := LatestDeployment(deployments); latest != nil {
+		return latest.ID
+	}
+	return ""
+}
+
+// LatestComposeDeploymentID is the compose counterpart of LatestApplicationDeploymentID.
+func (c *DokployClient) LatestComposeDeploymentID(composeID string) string {
+	deployments, err := c.ListComposeDeployments(composeID)
+	if err != nil {
+		return ""
+	}
+	if latest := LatestDeployment(deployments); latest != nil {
+		return latest.ID
+	}
+	return ""
+}
+
+// WaitForApplicationDeployment polls the application's deployments until one
+// newer than previousID reaches a terminal status or ctx is done.
+func (c *DokployClient) WaitForApplicationDeployment(ctx context.Context, appID, previousID string) (*Deployment, error) {
+	return waitForDeployment(ctx, func() ([]Deployment, error) {
+		return c.ListApplicationDeployments(appID)
+	}, previousID)
+}
+
+// WaitForComposeDeployment polls the compose stack's deployments until one
+// newer than previousID reaches a terminal status or ctx is done.
+func (c *DokployClient) WaitForComposeDeployment(ctx context.Context, composeID, previousID string) (*Deployment, error) {
+	return waitForDeployment(ctx, func() ([]Deployment, error) {
+		return c.ListComposeDeployments(composeID)
+	}, previousID)
+}
+
+func waitForDeployment(ctx context.Context, list func() ([]Deployment, error), previousID string) (*Deployment, error) {
+	var lastErr error
+	var current *Deployment
+	for {
+		deployments, err := list()
+		if err != nil {
+			lastErr = err
+		} else if latest := LatestDeployment(deployments); latest != nil && latest.ID != previousID {
+			current = latest
+			switch strings.ToLower(latest.Status) {
+			case "done":
+				return latest, nil
+			case "error", "cancelled":
+				return latest, &DeploymentError{Deployment: *latest}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			switch {
+			case current != nil:
+				return current, fmt.Errorf("timed out waiting for deployment %s (%q), last status %q", current.ID, current.Title, current.Status)
+			case lastErr != nil:
+				return nil, fmt.Errorf("timed out waiting for deployment to start: %w", lastErr)
+			default:
+				return nil, fmt.Errorf("timed out waiting for deployment to start")
+			}
+		case <-time.After(deploymentPollInterval):
+		}
+	}
+}
+
+// RollbackApplication restores the image of a previous application deployment.
+// Only deployments made while rollbacks were enabled on the application can be
+// restored.
+func (c *DokployClient) RollbackApplication(appID, deploymentID string) (*Deployment, error) {
+	deployments, err := c.ListApplicationDeployments(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *Deployment
+	for i := range deployments {
+		if deployments[i].ID == deploymentID {
+			target = &deployments[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("deployment %s not found for application %s", deploymentID, appID)
+	}
+
+	rollbackID := target.RollbackTargetID()
+	if rollbackID == "" {
+		return nil, fmt.Errorf("deployment %s (%q) has no rollback image; rollbacks must be enabled on the application when it is deployed", target.ID, target.Title)
+	}
+
+	payload := map[string]string{
+		"rollbackId": rollbackID,
+	}
+	if _, err := c.doRequest("POST", "rollback.rollback", payload); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// LatestDeployment returns the most recently created deployment. Dokploy
+// returns ISO-8601 timestamps, which order correctly as strings.
+func LatestDeployment(deployments []Deployment) *Deployment {
+	var latest *Deployment
+	for i := range deployments {
+		if latest == nil || deployments[i].CreatedAt > latest.CreatedAt {
+			latest = &deployments[i]
+		}
+	}
+	return latest
+}
+
+func parseDeploymentListResponse(resp []byte) ([]Deployment, error) {
+	if trimmed := strings.TrimSpace(string(resp)); trimmed == "" || trimmed == "null" {
+		return []Deployment{}, nil
+	}
+
+	var direct []Deployment
+	if err := json.Unmarshal(resp, &direct); err == nil {
+		return direct, nil
+	}
+
+	var wrapper struct {
+		Deployments []Deployment `json:"deployments"`
+	}
+	if err := json.Unmarshal(resp, &wrapper); err == nil && wrapper.Deployments != nil {
+		return wrapper.Deployments, nil
+	}
+
+	var dataWrapper struct {
+		Data []Deployment `json:"data"`
+	}
+	if err := json.Unmarshal(resp, &dataWrapper); err == nil && dataWrapper.Data != nil {
+		return dataWrapper.Data, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse deployment list response: %s", string(resp))
+}
+
+// ReadDeploymentLog reads a deployment's build log through Dokploy's
+// listen-deployment websocket. The server tails the log file indefinitely, so
+// the read ends once the stream has been idle for a short while.
+func (c *DokployClient) ReadDeploymentLog(ctx context.Context, deployment Deployment) (string, error) {
+	if strings.TrimSpace(deployment.LogPath) == "" {
+		return "", fmt.Errorf("deployment %s has no log path", deployment.ID)
+	}
+
+	endpoint, err := deploymentLogURL(c.BaseURL, deployment)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deploymentLogMaxDuration)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", base64.StdEncoding.EncodeToString(key))
+	req.Header.Set("x-api-key", c.APIKey)
+
+	// The shared client's timeout would cut the stream short; the context
+	// bounds this request instead.
+	httpClient := &http.Client{}
+	if c.HTTPClient != nil {
+		httpClient.Transport = c.HTTPClient.Transport
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return "", fmt.Errorf("log stream handshake failed: %s - %s", resp.Status, string(body))
+	}
+
+	frames := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		reader := bufio.NewReader(resp.Body)
+		for {
+			opcode, payload, err := readWebSocketFrame(reader)
+			if err != nil {
+				readErr <- err
+				return
+			}
+			switch opcode {
+			case 0x0, 0x1, 0x2: // continuation, text, binary
+				select {
+				case frames <- payload:
+				case <-ctx.Done():
+					return
+				}
+			case 0x8: // close
+				readErr <- io.EOF
+				return
+			}
+		}
+	}()
+
+	var log strings.Builder
+	idle := time.NewTimer(deploymentLogIdleTimeout)
+	defer idle.Stop()
+	for {
+		select {
+		case payload := <-frames:
+			if log.Len()+len(payload) > maxDeploymentLogBytes {
+				return log.String(), nil
+			}
+			log.Write(payload)
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(deploymentLogIdleTimeout)
+		case err := <-readErr:
+			if err == io.EOF || log.Len() > 0 {
+				return log.String(), nil
+			}
+			return "", err
+		case <-idle.C:
+			return log.String(), nil
+		case <-ctx.Done():
+			return log.String(), nil
+		}
+	}
+}
+
+// deploymentLogURL derives the listen-deployment endpoint from the API base
+// URL, which usually ends in /api.
+func deploymentLogURL(baseURL string, deployment Deployment) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+	parsed.Path = strings.TrimSuffix(strings.TrimSuffix(parsed.Path, "/"), "/api") + "/listen-deployment"
+
+	query := url.Values{}
+	query.Set("logPath", deployment.LogPath)
+	if strings.TrimSpace(deployment.ServerID) != "" {
+		query.Set("serverId", deployment.ServerID)
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// readWebSocketFrame reads a single frame from a websocket stream. Server
+// frames are normally unmasked; masking is handled for completeness.
+func readWebSocketFrame(r *bufio.Reader) (byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	opcode := header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+	length := uint64(header[1] & 0x7f)
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > maxDeploymentLogBytes {
+		return 0, nil, fmt.Errorf("websocket frame too large: %d bytes", length)
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(r, mask[:]); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return opcode, payload, nil
+}
+
+// TailLines returns at most the last n lines of s.
+func TailLines(s string, n int) string {
+	s = strings.TrimRight(s, "\n")
+	if n <= 0 || s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func useFastDeploymentPolling(t *testing.T) {
+	t.Helper()
+	previous := deploymentPollInterval
+	deploymentPollInterval = time.Millisecond
+	t.Cleanup(func() { deploymentPollInterval = previous })
+}
+
+func TestWaitForApplicationDeployment_ReturnsWhenDone(t *testing.T) {
+	useFastDeploymentPolling(t)
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/deployment.all":
+			if r.URL.Query().Get("applicationId") != "app-123" {
+				t.Fatalf("unexpected applicationId: %s", r.URL.Query().Get("applicationId"))
+			}
+			calls++
+			status := "running"
+			if calls >= 3 {
+				status = "done"
+			}
+			_, _ = w.Write([]byte(`[
+				{"deploymentId":"dep-old","title":"Old","status":"done","createdAt":"2026-01-01T10:00:00.000Z"},
+				{"deploymentId":"dep-new","title":"New","status":"` + status + `","createdAt":"2026-01-01T11:00:00.000Z"}
+			]`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	c.DisableRequestCache()
+
+	deployment, err := c.WaitForApplicationDeployment(context.Background(), "app-123", "dep-old")
+	if err != nil {
+		t.Fatalf("WaitForApplicationDeployment returned error: %v", err)
+	}
+	if deployment.ID != "dep-new" || deployment.Status != "done" {
+		t.Fatalf("unexpected deployment: %+v", deployment)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 polls, got %d", calls)
+	}
+}
+
+func TestWaitForComposeDeployment_ReturnsDeploymentError(t *testing.T) {
+	useFastDeploymentPolling(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/deployment.allByCompose":
+			_, _ = w.Write([]byte(`[{"deploymentId":"dep-1","title":"Build","status":"error","errorMessage":"exit code 1","createdAt":"2026-01-01T10:00:00.000Z"}]`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	_, err := c.WaitForComposeDeployment(context.Background(), "compose-123", "")
+
+	var deploymentErr *DeploymentError
+	if !errors.As(err, &deploymentErr) {
+		t.Fatalf("expected DeploymentError, got %v", err)
+	}
+	if deploymentErr.Deployment.Title != "Build" || !strings.Contains(err.Error(), "exit code 1") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWaitForApplicationDeployment_TimesOutWithoutNewDeployment(t *testing.T) {
+	useFastDeploymentPolling(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"deploymentId":"dep-old","status":"done","createdAt":"2026-01-01T10:00:00.000Z"}]`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.WaitForApplicationDeployment(ctx, "app-123", "dep-old")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+}
+
+func TestReadDeploymentLog_ReadsWebSocketStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/listen-deployment" {
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("logPath") != "/etc/dokploy/logs/app/build.log" {
+			t.Fatalf("unexpected logPath: %s", r.URL.Query().Get("logPath"))
+		}
+		if r.Header.Get("x-api-key") != "test-key" {
+			t.Fatalf("missing api key header")
+		}
+
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("hijack failed: %v", err)
+		}
+		defer conn.Close()
+		_, _ = buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		for _, chunk := range []string{"step 1\n", "step 2\n", "failed\n"} {
+			_, _ = buf.Write(append([]byte{0x81, byte(len(chunk))}, chunk...))
+		}
+		_, _ = buf.Write([]byte{0x88, 0x00})
+		_ = buf.Flush()
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL+"/api", "test-key")
+	log, err := c.ReadDeploymentLog(context.Background(), Deployment{ID: "dep-1", LogPath: "/etc/dokploy/logs/app/build.log"})
+	if err != nil {
+		t.Fatalf("ReadDeploymentLog returned error: %v", err)
+	}
+	if log != "step 1\nstep 2\nfailed\n" {
+		t.Fatalf("unexpected log: %q", log)
+	}
+	if tail := TailLines(log, 2); tail != "step 2\nfailed" {
+		t.Fatalf("unexpected tail: %q", tail)
+	}
+}
+
+func TestParseDeploymentListResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected int
+	}{
+		{name: "direct", body: `[{"deploymentId":"dep-1","commitHash":"abc123"}]`, expected: 1},
+		{name: "wrapped", body: `{"deployments":[{"deploymentId":"dep-1"},{"deploymentId":"dep-2"}]}`, expected: 2},
+		{name: "data", body: `{"data":[{"deploymentId":"dep-1"}]}`, expected: 1},
+		{name: "null", body: `null`, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployments, err := parseDeploymentListResponse([]byte(test.body))
+			if err != nil {
+				t.Fatalf("parseDeploymentListResponse returned error: %v", err)
+			}
+			if len(deployments) != test.expected {
+				t.Fatalf("unexpected deployment count: got %d want %d", len(deployments), test.expected)
+			}
+		})
+	}
+
+	if _, err := parseDeploymentListResponse([]byte(`"nope"`)); err == nil {
+		t.Fatalf("expected error for unexpected response")
+	}
+}
+
+func TestRollbackApplication_UsesDeploymentRollbackID(t *testing.T) {
+	rolledBack := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/deployment.all":
+			_, _ = w.Write([]byte(`[
+				{"deploymentId":"dep-2","title":"Bad release","createdAt":"2026-01-02T10:00:00.000Z"},
+				{"deploymentId":"dep-1","title":"Good release","createdAt":"2026-01-01T10:00:00.000Z","rollback":{"rollbackId":"rb-1","image":"registry/app:v1"}}
+			]`))
+		case "/rollback.rollback":
+			var payload map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			rolledBack = payload["rollbackId"]
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Fatalf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	deployment, err := c.RollbackApplication("app-123", "dep-1")
+	if err != nil {
+		t.Fatalf("RollbackApplication returned error: %v", err)
+	}
+	if deployment.Title != "Good release" || rolledBack != "rb-1" {
+		t.Fatalf("unexpected rollback: deployment %+v, rollbackId %q", deployment, rolledBack)
+	}
+
+	if _, err := c.RollbackApplication("app-123", "dep-2"); err == nil || !strings.Contains(err.Error(), "no rollback image") {
+		t.Fatalf("expected error for deployment without rollback image, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// gitProviderEndpointNames maps the git provider source types to the
+// capitalization Dokploy uses in its save*Provider endpoint names.
+var gitProviderEndpointNames = map[string]string{
+	"gitlab":    "Gitlab",
+	"bitbucket": "Bitbucket",
+	"gitea":     "Gitea",
+}
+
+// IsGitProviderSourceType reports whether sourceType is a repository hosted on
+// a GitLab, Bitbucket or Gitea provider configured in Dokploy.
+func IsGitProviderSourceType(sourceType string) bool {
+	_, ok := gitProviderEndpointNames[sourceType]
+	return ok
+}
+
+// GitProviderSource is a repository on a GitHub, GitLab, Bitbucket or Gitea
+// provider. Dokploy stores the fields with the provider name as prefix, e.g.
+// gitlabRepository or giteaBranch, except for GitHub which predates the
+// other providers and uses repository, owner and branch.
+type GitProviderSource struct {
+	Provider         string
+	ProviderID       string
+	Repository       string
+	Owner            string
+	Branch           string
+	BuildPath        string
+	WatchPaths       []string
+	EnableSubmodules bool
+	// GitHub only: push or tag.
+	TriggerType string
+	// GitLab only.
+	ProjectID *int64
+}
+
+// GitProviderSource returns the application's settings for provider.
+func (a Application) GitProviderSource(provider string) GitProviderSource {
+	source := GitProviderSource{
+		Provider:         provider,
+		WatchPaths:       a.GithubWatchPaths,
+		EnableSubmodules: a.EnableSubmodules,
+	}
+	switch provider {
+	case "gitlab":
+		source.ProviderID, source.Repository, source.Owner = a.GitlabID, a.GitlabRepository, a.GitlabOwner
+		source.Branch, source.BuildPath, source.ProjectID = a.GitlabBranch, a.GitlabBuildPath, a.GitlabProjectID
+	case "bitbucket":
+		source.ProviderID, source.Repository, source.Owner = a.BitbucketID, a.BitbucketRepository, a.BitbucketOwner
+		source.Branch, source.BuildPath = a.BitbucketBranch, a.BitbucketBuildPath
+	case "gitea":
+		source.ProviderID, source.Repository, source.Owner = a.GiteaID, a.GiteaRepository, a.GiteaOwner
+		source.Branch, source.BuildPath = a.GiteaBranch, a.GiteaBuildPath
+	}
+	return source
+}
+
+// GitProviderSource returns the compose stack's settings for provider. Compose
+// stacks use composePath instead of a build path.
+func (c Compose) GitProviderSource(provider string) GitProviderSource {
+	source := GitProviderSource{
+		Provider:         provider,
+		WatchPaths:       c.WatchPaths,
+		EnableSubmodules: c.EnableSubmodules,
+	}
+	switch provider {
+	case "github":
+		source.ProviderID, source.Repository, source.Owner = c.GithubID, c.Repository, c.Owner
+		source.Branch, source.TriggerType = c.Branch, c.TriggerType
+	case "gitlab":
+		source.ProviderID, source.Repository, source.Owner = c.GitlabID, c.GitlabRepository, c.GitlabOwner
+		source.Branch, source.ProjectID = c.GitlabBranch, c.GitlabProjectID
+	case "bitbucket":
+		source.ProviderID, source.Repository, source.Owner = c.BitbucketID, c.BitbucketRepository, c.BitbucketOwner
+		source.Branch = c.BitbucketBranch
+	case "gitea":
+		source.ProviderID, source.Repository, source.Owner = c.GiteaID, c.GiteaRepository, c.GiteaOwner
+		source.Branch = c.GiteaBranch
+	}
+	return source
+}
+
+func gitProviderPayload(source GitProviderSource, withBuildPath bool) map[string]interface{} {
+	prefix := source.Provider
+	repositoryKey, ownerKey, branchKey := prefix+"Repository", prefix+"Owner", prefix+"Branch"
+	if source.Provider == "github" {
+		repositoryKey, ownerKey, branchKey = "repository", "owner", "branch"
+	}
+	watchPaths := source.WatchPaths
+	if watchPaths == nil {
+		watchPaths = []string{}
+	}
+	payload := map[string]interface{}{
+		prefix + "Id":      source.ProviderID,
+		repositoryKey:      source.Repository,
+		ownerKey:           source.Owner,
+		branchKey:          source.Branch,
+		"watchPaths":       watchPaths,
+		"enableSubmodules": source.EnableSubmodules,
+	}
+	if withBuildPath {
+		buildPath := source.BuildPath
+		if buildPath == "" {
+			buildPath = "/"
+		}
+		payload[prefix+"BuildPath"] = buildPath
+	}
+
+	switch source.Provider {
+	case "github":
+		triggerType := source.TriggerType
+		if triggerType == "" {
+			triggerType = "push"
+		}
+		payload["triggerType"] = triggerType
+	case "gitlab":
+		// Dokploy clones GitLab repositories by their namespaced path.
+		payload["gitlabPathNamespace"] = strings.Trim(source.Owner+"/"+source.Repository, "/")
+		payload["gitlabProjectId"] = source.ProjectID
+	case "bitbucket":
+		payload["bitbucketRepositorySlug"] = source.Repository
+	}
+	return payload
+}
+
+// SaveApplicationGitProvider points an application at a GitLab, Bitbucket or
+// Gitea repository through the matching application.save*Provider endpoint.
+func (c *DokployClient) SaveApplicationGitProvider(appID string, source GitProviderSource) error {
+	name, ok := gitProviderEndpointNames[source.Provider]
+	if !ok {
+		return fmt.Errorf("unsupported git provider: %s", source.Provider)
+	}
+	defer c.lockTarget("application", appID, lockFamilyConfig)()
+
+	payload := gitProviderPayload(source, true)
+	payload["applicationId"] = appID
+
+	_, err := c.doRequest("POST", fmt.Sprintf("application.save%sProvider", name), payload)
+	return err
+}
+
+// SaveComposeGitProvider points a compose stack at a GitHub, GitLab,
+// Bitbucket or Gitea repository. Compose has no save*Provider endpoints, so
+// the fields are sent through compose.update together with the source type.
+func (c *DokployClient) SaveComposeGitProvider(composeID string, source GitProviderSource) error {
+	if source.Provider != "github" && !IsGitProviderSourceType(source.Provider) {
+		return fmt.Errorf("unsupported git provider: %s", source.Provider)
+	}
+	defer c.lockTarget("compose", composeID, lockFamilyConfig)()
+
+	payload := gitProviderPayload(source, false)
+	payload["composeId"] = composeID
+	payload["sourceType"] = source.Provider
+
+	_, err := c.doRequest("POST", "compose.update", payload)
+	return err
+}
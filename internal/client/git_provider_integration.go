@@ -0,0 +1,314 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GitProvider is a git hosting integration configured in Dokploy. The nested
+// provider record matching ProviderType holds the ID that applications and
+// compose stacks reference, e.g. githubId or gitlabId.
+type GitProvider struct {
+	ID           string             `json:"gitProviderId"`
+	Name         string             `json:"name"`
+	ProviderType string             `json:"providerType"`
+	CreatedAt    string             `json:"createdAt"`
+	Github       *GithubProvider    `json:"github"`
+	Gitlab       *GitlabProvider    `json:"gitlab"`
+	Bitbucket    *BitbucketProvider `json:"bitbucket"`
+	Gitea        *GiteaProvider     `json:"gitea"`
+}
+
+// IntegrationID returns the provider specific ID, or an empty string when the
+// response did not include the nested provider record.
+func (p GitProvider) IntegrationID() string {
+	switch {
+	case p.ProviderType == "github" && p.Github != nil:
+		return p.Github.ID
+	case p.ProviderType == "gitlab" && p.Gitlab != nil:
+		return p.Gitlab.ID
+	case p.ProviderType == "bitbucket" && p.Bitbucket != nil:
+		return p.Bitbucket.ID
+	case p.ProviderType == "gitea" && p.Gitea != nil:
+		return p.Gitea.ID
+	}
+	return ""
+}
+
+// GithubProvider is a GitHub App integration. GitHub Apps are installed
+// through the Dokploy UI, so they can only be looked up.
+type GithubProvider struct {
+	ID            string       `json:"githubId"`
+	GitProviderID string       `json:"gitProviderId"`
+	AppName       string       `json:"githubAppName"`
+	GitProvider   *GitProvider `json:"gitProvider"`
+}
+
+// GitlabProvider is a GitLab integration.
+type GitlabProvider struct {
+	ID            string       `json:"gitlabId"`
+	GitProviderID string       `json:"gitProviderId"`
+	Name          string       `json:"-"`
+	GitlabURL     string       `json:"gitlabUrl"`
+	ApplicationID string       `json:"applicationId"`
+	RedirectURI   string       `json:"redirectUri"`
+	GroupName     string       `json:"groupName"`
+	Secret        string       `json:"-"`
+	AccessToken   string       `json:"-"`
+	GitProvider   *GitProvider `json:"gitProvider"`
+}
+
+// BitbucketProvider is a Bitbucket integration authenticated with an app
+// password or an API token.
+type BitbucketProvider struct {
+	ID            string       `json:"bitbucketId"`
+	GitProviderID string       `json:"gitProviderId"`
+	Name          string       `json:"-"`
+	Username      string       `json:"bitbucketUsername"`
+	Email         string       `json:"bitbucketEmail"`
+	WorkspaceName string       `json:"bitbucketWorkspaceName"`
+	AppPassword   string       `json:"-"`
+	APIToken      string       `json:"-"`
+	GitProvider   *GitProvider `json:"gitProvider"`
+}
+
+// GiteaProvider is a Gitea integration.
+type GiteaProvider struct {
+	ID            string       `json:"giteaId"`
+	GitProviderID string       `json:"gitProviderId"`
+	Name          string       `json:"-"`
+	GiteaURL      string       `json:"giteaUrl"`
+	ClientID      string       `json:"clientId"`
+	RedirectURI   string       `json:"redirectUri"`
+	ClientSecret  string       `json:"-"`
+	AccessToken   string       `json:"-"`
+	GitProvider   *GitProvider `json:"gitProvider"`
+}
+
+// ListGitProviders returns all git provider integrations of the organization.
+func (c *DokployClient) ListGitProviders() ([]GitProvider, error) {
+	resp, err := c.doRequest("GET", "gitProvider.getAll", nil)
+	if err != nil {
+		return nil, err
+	}
+	var providers []GitProvider
+	if err := json.Unmarshal(resp, &providers); err != nil {
+		return nil, fmt.Errorf("failed to parse gitProvider.getAll response: %w", err)
+	}
+	return providers, nil
+}
+
+// findGitProvider returns the newest integration of providerType named name.
+// Create endpoints do not consistently return the new record, so it is looked
+// up afterwards.
+func (c *DokployClient) findGitProvider(providerType, name string) (*GitProvider, error) {
+	providers, err := c.ListGitProviders()
+	if err != nil {
+		return nil, err
+	}
+	var found *GitProvider
+	for i := range providers {
+		provider := providers[i]
+		if provider.ProviderType != providerType || provider.Name != name {
+			continue
+		}
+		if found == nil || provider.CreatedAt > found.CreatedAt {
+			found = &provider
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%s provider %q not found", providerType, name)
+	}
+	return found, nil
+}
+
+// DeleteGitProvider removes a git provider integration of any type.
+func (c *DokployClient) DeleteGitProvider(gitProviderID string) error {
+	_, err := c.doRequest("POST", "gitProvider.remove", map[string]string{
+		"gitProviderId": gitProviderID,
+	})
+	return err
+}
+
+func (c *DokployClient) getGitProviderRecord(providerType, id string, out interface{}) error {
+	endpoint := fmt.Sprintf("%s.one?%sId=%s", providerType, providerType, url.QueryEscape(id))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(resp, out); err != nil {
+		return fmt.Errorf("failed to parse %s.one response: %w", providerType, err)
+	}
+	return nil
+}
+
+// createdGitProviderID returns the provider specific ID from a create
+// response, falling back to a lookup by name.
+func (c *DokployClient) createdGitProviderID(providerType, name string, resp []byte) (string, error) {
+	var created map[string]interface{}
+	if err := json.Unmarshal(resp, &created); err == nil {
+		if id, ok := created[providerType+"Id"].(string); ok && id != "" {
+			return id, nil
+		}
+	}
+	found, err := c.findGitProvider(providerType, name)
+	if err != nil {
+		return "", fmt.Errorf("%s provider created but it could not be found: %w", providerType, err)
+	}
+	if id := found.IntegrationID(); id != "" {
+		return id, nil
+	}
+	return "", fmt.Errorf("%s provider %q has no %sId", providerType, name, providerType)
+}
+
+// nullIfEmpty sends unset optional strings as null, which Dokploy treats as
+// "not configured".
+func nullIfEmpty(value string) interface{} {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return value
+}
+
+func (c *DokployClient) GetGithubProvider(id string) (*GithubProvider, error) {
+	var provider GithubProvider
+	if err := c.getGitProviderRecord("github", id, &provider); err != nil {
+		return nil, err
+	}
+	return &provider, nil
+}
+
+func (c *DokployClient) GetGitlabProvider(id string) (*GitlabProvider, error) {
+	var provider GitlabProvider
+	if err := c.getGitProviderRecord("gitlab", id, &provider); err != nil {
+		return nil, err
+	}
+	if provider.GitProvider != nil {
+		provider.Name = provider.GitProvider.Name
+	}
+	return &provider, nil
+}
+
+func gitlabProviderPayload(provider GitlabProvider) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          provider.Name,
+		"gitlabUrl":     provider.GitlabURL,
+		"applicationId": nullIfEmpty(provider.ApplicationID),
+		"redirectUri":   nullIfEmpty(provider.RedirectURI),
+		"groupName":     nullIfEmpty(provider.GroupName),
+		"secret":        nullIfEmpty(provider.Secret),
+		"accessToken":   nullIfEmpty(provider.AccessToken),
+	}
+}
+
+func (c *DokployClient) CreateGitlabProvider(provider GitlabProvider) (*GitlabProvider, error) {
+	resp, err := c.doRequest("POST", "gitlab.create", gitlabProviderPayload(provider))
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.createdGitProviderID("gitlab", provider.Name, resp)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetGitlabProvider(id)
+}
+
+func (c *DokployClient) UpdateGitlabProvider(provider GitlabProvider) (*GitlabProvider, error) {
+	payload := gitlabProviderPayload(provider)
+	payload["gitlabId"] = provider.ID
+	payload["gitProviderId"] = provider.GitProviderID
+	if _, err := c.doRequest("POST", "gitlab.update", payload); err != nil {
+		return nil, err
+	}
+	return c.GetGitlabProvider(provider.ID)
+}
+
+func (c *DokployClient) GetBitbucketProvider(id string) (*BitbucketProvider, error) {
+	var provider BitbucketProvider
+	if err := c.getGitProviderRecord("bitbucket", id, &provider); err != nil {
+		return nil, err
+	}
+	if provider.GitProvider != nil {
+		provider.Name = provider.GitProvider.Name
+	}
+	return &provider, nil
+}
+
+func bitbucketProviderPayload(provider BitbucketProvider) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                   provider.Name,
+		"bitbucketUsername":      provider.Username,
+		"bitbucketEmail":         nullIfEmpty(provider.Email),
+		"bitbucketWorkspaceName": nullIfEmpty(provider.WorkspaceName),
+		"appPassword":            nullIfEmpty(provider.AppPassword),
+		"apiToken":               nullIfEmpty(provider.APIToken),
+	}
+}
+
+func (c *DokployClient) CreateBitbucketProvider(provider BitbucketProvider) (*BitbucketProvider, error) {
+	resp, err := c.doRequest("POST", "bitbucket.create", bitbucketProviderPayload(provider))
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.createdGitProviderID("bitbucket", provider.Name, resp)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetBitbucketProvider(id)
+}
+
+func (c *DokployClient) UpdateBitbucketProvider(provider BitbucketProvider) (*BitbucketProvider, error) {
+	payload := bitbucketProviderPayload(provider)
+	payload["bitbucketId"] = provider.ID
+	payload["gitProviderId"] = provider.GitProviderID
+	if _, err := c.doRequest("POST", "bitbucket.update", payload); err != nil {
+		return nil, err
+	}
+	return c.GetBitbucketProvider(provider.ID)
+}
+
+func (c *DokployClient) GetGiteaProvider(id string) (*GiteaProvider, error) {
+	var provider GiteaProvider
+	if err := c.getGitProviderRecord("gitea", id, &provider); err != nil {
+		return nil, err
+	}
+	if provider.GitProvider != nil {
+		provider.Name = provider.GitProvider.Name
+	}
+	return &provider, nil
+}
+
+func giteaProviderPayload(provider GiteaProvider) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         provider.Name,
+		"giteaUrl":     provider.GiteaURL,
+		"clientId":     nullIfEmpty(provider.ClientID),
+		"redirectUri":  nullIfEmpty(provider.RedirectURI),
+		"clientSecret": nullIfEmpty(provider.ClientSecret),
+		"accessToken":  nullIfEmpty(provider.AccessToken),
+	}
+}
+
+func (c *DokployClient) CreateGiteaProvider(provider GiteaProvider) (*GiteaProvider, error) {
+	resp, err := c.doRequest("POST", "gitea.create", giteaProviderPayload(provider))
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.createdGitProviderID("gitea", provider.Name, resp)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetGiteaProvider(id)
+}
+
+func (c *DokployClient) UpdateGiteaProvider(provider GiteaProvider) (*GiteaProvider, error) {
+	payload := giteaProviderPayload(provider)
+	payload["giteaId"] = provider.ID
+	payload["gitProviderId"] = provider.GitProviderID
+	if _, err := c.doRequest("POST", "gitea.update", payload); err != nil {
+		return nil, err
+	}
+	return c.GetGiteaProvider(provider.ID)
+}
@@ -0,0 +1,48 @@
+package client
+
+import "sync"
+
+// Lock families used to partition mutations of a single Dokploy object.
+// Mutations in the same family read-modify-write the same data and must not
+// interleave; different families on the same object may run concurrently.
+const (
+	lockFamilyEnv     = "env"
+	lockFamilyConfig  = "config"
+	lockFamilyPorts   = "ports"
+	lockFamilyMounts  = "mounts"
+	lockFamilyDomains = "domains"
+)
+
+// targetLocks is a registry of mutexes keyed by target object and operation
+// family. Terraform applies resources in parallel, so several resources can
+// mutate the same application, compose stack or project at once; holding the
+// target lock serializes them within a single provider process.
+type targetLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func (l *targetLocks) lock(key string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*sync.Mutex{}
+	}
+	m, ok := l.locks[key]
+	if !ok {
+		m = &sync.Mutex{}
+		l.locks[key] = m
+	}
+	l.mu.Unlock()
+
+	m.Lock()
+	return m.Unlock
+}
+
+// lockTarget acquires the lock for a (kind, id, family) target and returns
+// the matching unlock function. Targets without an ID are not locked.
+func (c *DokployClient) lockTarget(kind, id, family string) func() {
+	if id == "" {
+		return func() {}
+	}
+	return c.locks.lock(kind + ":" + id + ":" + family)
+}
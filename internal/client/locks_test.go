@@ -0,0 +1,136 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUpdateApplicationEnv_SerializesConcurrentWriters(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		appEnv      string
+		inFlight    int32
+		maxInFlight int32
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.one":
+			mu.Lock()
+			env := appEnv
+			mu.Unlock()
+			_ = json.NewEncoder(w).Encode(map[string]string{"applicationId": "app-123", "env": env})
+		case "/application.saveEnvironment":
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				observed := atomic.LoadInt32(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("failed to decode application.saveEnvironment payload: %v", err)
+				return
+			}
+			mu.Lock()
+			appEnv, _ = payload["env"].(string)
+			mu.Unlock()
+			_, _ = w.Write([]byte(`true`))
+		default:
+			t.Errorf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	keys := []string{"A", "B", "C", "D", "E"}
+
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			err := c.UpdateApplicationEnv("app-123", func(envMap map[string]string) {
+				envMap[key] = "1"
+			}, nil)
+			if err != nil {
+				t.Errorf("UpdateApplicationEnv(%s) returned error: %v", key, err)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got != 1 {
+		t.Fatalf("expected serialized saveEnvironment calls, got %d concurrent", got)
+	}
+	finalEnv := ParseEnv(appEnv)
+	for _, key := range keys {
+		if finalEnv[key] != "1" {
+			t.Fatalf("expected %s to survive concurrent writes, got env %#v", key, finalEnv)
+		}
+	}
+}
+
+func TestLockTarget_DifferentTargetsDoNotBlock(t *testing.T) {
+	c := NewDokployClient("http://localhost", "test-key")
+
+	unlockEnv := c.lockTarget("application", "app-1", lockFamilyEnv)
+	defer unlockEnv()
+
+	done := make(chan struct{})
+	go func() {
+		c.lockTarget("application", "app-1", lockFamilyPorts)()
+		c.lockTarget("application", "app-2", lockFamilyEnv)()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("locks for unrelated targets blocked on a held lock")
+	}
+}
+
+func TestDeleteDomain_WaitsForParentDomainLock(t *testing.T) {
+	var called int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domain.remove" {
+			t.Errorf("unexpected endpoint called: %s", r.URL.Path)
+		}
+		atomic.StoreInt32(&called, 1)
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	unlock := c.lockTarget("application", "app-1", lockFamilyDomains)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.DeleteDomain(Domain{ID: "domain-1", ApplicationID: "app-1"})
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	if atomic.LoadInt32(&called) != 0 {
+		t.Fatal("DeleteDomain ran while the application's domain lock was held")
+	}
+	unlock()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("DeleteDomain returned error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("DeleteDomain did not finish after the lock was released")
+	}
+}
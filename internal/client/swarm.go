@@ -0,0 +1,84 @@
+package client
+
+// Swarm settings mirror the Docker Engine service spec fields that Dokploy
+// stores per application. Durations are in nanoseconds, as in the Engine API.
+
+type HealthCheckSwarm struct {
+	Test        []string `json:"Test,omitempty"`
+	Interval    *int64   `json:"Interval,omitempty"`
+	Timeout     *int64   `json:"Timeout,omitempty"`
+	StartPeriod *int64   `json:"StartPeriod,omitempty"`
+	Retries     *int64   `json:"Retries,omitempty"`
+}
+
+type RestartPolicySwarm struct {
+	Condition   string `json:"Condition,omitempty"`
+	Delay       *int64 `json:"Delay,omitempty"`
+	MaxAttempts *int64 `json:"MaxAttempts,omitempty"`
+	Window      *int64 `json:"Window,omitempty"`
+}
+
+type PlacementSwarm struct {
+	Constraints []string                   `json:"Constraints,omitempty"`
+	Preferences []PlacementPreferenceSwarm `json:"Preferences,omitempty"`
+	MaxReplicas *int64                     `json:"MaxReplicas,omitempty"`
+}
+
+type PlacementPreferenceSwarm struct {
+	Spread SpreadOverSwarm `json:"Spread"`
+}
+
+type SpreadOverSwarm struct {
+	SpreadDescriptor string `json:"SpreadDescriptor"`
+}
+
+// UpdateConfigSwarm is used for both updateConfigSwarm and rollbackConfigSwarm.
+type UpdateConfigSwarm struct {
+	Parallelism     int64    `json:"Parallelism"`
+	Delay           *int64   `json:"Delay,omitempty"`
+	FailureAction   string   `json:"FailureAction,omitempty"`
+	Monitor         *int64   `json:"Monitor,omitempty"`
+	MaxFailureRatio *float64 `json:"MaxFailureRatio,omitempty"`
+	Order           string   `json:"Order"`
+}
+
+type ModeSwarm struct {
+	Replicated *ReplicatedModeSwarm `json:"Replicated,omitempty"`
+	Global     *GlobalModeSwarm     `json:"Global,omitempty"`
+}
+
+type ReplicatedModeSwarm struct {
+	Replicas *int64 `json:"Replicas,omitempty"`
+}
+
+type GlobalModeSwarm struct{}
+
+type NetworkSwarm struct {
+	Target     string            `json:"Target,omitempty"`
+	Aliases    []string          `json:"Aliases,omitempty"`
+	DriverOpts map[string]string `json:"DriverOpts,omitempty"`
+}
+
+func addSwarmApplicationPayload(payload map[string]interface{}, app Application) {
+	if app.HealthCheckSwarm != nil {
+		payload["healthCheckSwarm"] = app.HealthCheckSwarm
+	}
+	if app.RestartPolicySwarm != nil {
+		payload["restartPolicySwarm"] = app.RestartPolicySwarm
+	}
+	if app.PlacementSwarm != nil {
+		payload["placementSwarm"] = app.PlacementSwarm
+	}
+	if app.UpdateConfigSwarm != nil {
+		payload["updateConfigSwarm"] = app.UpdateConfigSwarm
+	}
+	if app.RollbackConfigSwarm != nil {
+		payload["rollbackConfigSwarm"] = app.RollbackConfigSwarm
+	}
+	if app.ModeSwarm != nil {
+		payload["modeSwarm"] = app.ModeSwarm
+	}
+	if app.NetworkSwarm != nil {
+		payload["networkSwarm"] = app.NetworkSwarm
+	}
+}
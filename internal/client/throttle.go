@@ -0,0 +1,149 @@
+package client
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxThrottledRetries bounds how often a request rejected with 429, 503 or,
+	// for GET requests, 502 is retried before the error is surfaced.
+	maxThrottledRetries = 3
+	// maxRetryAfter caps server-provided Retry-After delays.
+	maxRetryAfter = 60 * time.Second
+)
+
+// throttledRetryBackoff is the base delay for retries without Retry-After;
+// the n-th retry waits n times as long.
+var throttledRetryBackoff = time.Second
+
+// requestThrottle combines a token-bucket rate limiter with a cap on
+// in-flight requests. A zero rate or concurrency means unlimited. Retry-After
+// responses pause every request issued through the throttle, not only the
+// one that was rejected.
+type requestThrottle struct {
+	mu         sync.Mutex
+	rate       float64
+	burst      float64
+	tokens     float64
+	last       time.Time
+	pauseUntil time.Time
+	slots      chan struct{}
+}
+
+func newRequestThrottle(requestsPerSecond float64, maxConcurrent int) *requestThrottle {
+	t := &requestThrottle{}
+	if requestsPerSecond > 0 {
+		t.rate = requestsPerSecond
+		t.burst = math.Max(1, math.Ceil(requestsPerSecond))
+		t.tokens = t.burst
+		t.last = time.Now()
+	}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+// acquire blocks until a request may be sent and returns the function that
+// releases its concurrency slot.
+func (t *requestThrottle) acquire() func() {
+	if t.slots != nil {
+		t.slots <- struct{}{}
+	}
+	for {
+		delay := t.reserve()
+		if delay <= 0 {
+			break
+		}
+		time.Sleep(delay)
+	}
+	if t.slots == nil {
+		return func() {}
+	}
+	return func() { <-t.slots }
+}
+
+// reserve takes a token when one is available and otherwise reports how long
+// to wait before trying again.
+func (t *requestThrottle) reserve() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(t.pauseUntil) {
+		return t.pauseUntil.Sub(now)
+	}
+	if t.rate <= 0 {
+		return 0
+	}
+
+	t.tokens = math.Min(t.burst, t.tokens+now.Sub(t.last).Seconds()*t.rate)
+	t.last = now
+	if t.tokens >= 1 {
+		t.tokens--
+		return 0
+	}
+	return time.Duration((1 - t.tokens) / t.rate * float64(time.Second))
+}
+
+// pause delays all subsequent requests until the given duration has elapsed.
+func (t *requestThrottle) pause(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until := time.Now().Add(d); until.After(t.pauseUntil) {
+		t.pauseUntil = until
+	}
+}
+
+// throttledRetryDelay reports whether a response asks the client to back off
+// and for how long. 429s and 503s carrying Retry-After qualify for every
+// method: those requests were rejected before being processed and are safe to
+// resend. 502s and other 503s come from the reverse proxy in front of Dokploy,
+// which may have forwarded the request already, so they are only retried for
+// GET requests. Retry-After wins when present; otherwise the delay grows
+// linearly with attempt.
+func throttledRetryDelay(method string, resp *http.Response, attempt int) (time.Duration, bool) {
+	retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	switch {
+	case resp.StatusCode == http.StatusServiceUnavailable && hasRetryAfter:
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case method == http.MethodGet && (resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusServiceUnavailable):
+	default:
+		return 0, false
+	}
+	if hasRetryAfter {
+		return retryAfter, true
+	}
+	return time.Duration(attempt+1) * throttledRetryBackoff, true
+}
+
+// parseRetryAfter parses a Retry-After header in either delay-seconds or
+// HTTP-date form.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		delay = date.Sub(now)
+	} else {
+		return 0, false
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > maxRetryAfter {
+		delay = maxRetryAfter
+	}
+	return delay, true
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "seconds", value: "3", expected: 3 * time.Second, ok: true},
+		{name: "http date", value: now.Add(5 * time.Second).Format(http.TimeFormat), expected: 5 * time.Second, ok: true},
+		{name: "capped", value: "3600", expected: maxRetryAfter, ok: true},
+		{name: "past date", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+		{name: "empty", value: "", ok: false},
+		{name: "invalid", value: "soon", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(test.value, now)
+			if ok != test.ok || got != test.expected {
+				t.Fatalf("unexpected result: got (%s, %t) want (%s, %t)", got, ok, test.expected, test.ok)
+			}
+		})
+	}
+}
+
+func TestDoRequest_RetriesAfterTooManyRequests(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if err := c.DeployApplication("app-123"); err != nil {
+		t.Fatalf("DeployApplication returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected request to be retried once, got %d calls", calls)
+	}
+}
+
+func TestDoRequest_RetriesGatewayErrorsForGetWithBackoff(t *testing.T) {
+	previous := throttledRetryBackoff
+	throttledRetryBackoff = 10 * time.Millisecond
+	defer func() { throttledRetryBackoff = previous }()
+
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls < 3 {
+					w.WriteHeader(status)
+					return
+				}
+				_, _ = w.Write([]byte(`{"applicationId":"app-123"}`))
+			}))
+			defer server.Close()
+
+			c := NewDokployClient(server.URL, "test-key")
+			start := time.Now()
+			if _, err := c.GetApplication("app-123"); err != nil {
+				t.Fatalf("GetApplication returned error: %v", err)
+			}
+			if calls != 3 {
+				t.Fatalf("expected two retries, got %d calls", calls)
+			}
+			if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+				t.Fatalf("expected linear backoff between retries, finished after %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestDoRequest_GivesUpOnPersistentGatewayErrors(t *testing.T) {
+	previous := throttledRetryBackoff
+	throttledRetryBackoff = time.Millisecond
+	defer func() { throttledRetryBackoff = previous }()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	if _, err := c.GetApplication("app-123"); err == nil {
+		t.Fatal("expected GetApplication to return an error")
+	}
+	if calls != maxThrottledRetries+1 {
+		t.Fatalf("expected %d calls, got %d", maxThrottledRetries+1, calls)
+	}
+}
+
+func TestDoRequest_DoesNotRetryBadGateway(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			c := NewDokployClient(server.URL, "test-key")
+			if err := c.DeployApplication("app-123"); err == nil {
+				t.Fatal("expected DeployApplication to return an error")
+			}
+			if calls != 1 {
+				t.Fatalf("expected a single call for %d without Retry-After, got %d", status, calls)
+			}
+		})
+	}
+}
+
+func TestSetRateLimit_CapsConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`true`))
+	}))
+	defer server.Close()
+
+	c := NewDokployClient(server.URL, "test-key")
+	c.SetRateLimit(0, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.DeployApplication("app-123"); err != nil {
+				t.Errorf("DeployApplication returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", got)
+	}
+}
+
+func TestRequestThrottle_RateLimitsBeyondBurst(t *testing.T) {
+	throttle := newRequestThrottle(20, 0)
+
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		throttle.acquire()()
+	}
+	// 20 requests fit in the initial burst; the remaining 5 need ~250ms.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected rate limiting to delay requests, finished in %s", elapsed)
+	}
+}
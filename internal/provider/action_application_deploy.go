@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ action.Action = &ApplicationDeployAction{}
+var _ action.ActionWithConfigure = &ApplicationDeployAction{}
+
+func NewApplicationDeployAction() action.Action {
+	return &ApplicationDeployAction{}
+}
+
+type ApplicationDeployAction struct {
+	client *client.DokployClient
+}
+
+type ApplicationDeployActionModel struct {
+	ApplicationID     types.String `tfsdk:"application_id"`
+	WaitForDeployment types.Bool   `tfsdk:"wait_for_deployment"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+func (a *ApplicationDeployAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_deploy"
+}
+
+func (a *ApplicationDeployAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a deployment of a Dokploy application.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, waits for the deployment to finish and fails when the deployment fails.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the deployment, as a duration such as \"30m\". Defaults to 20m.",
+			},
+		},
+	}
+}
+
+func (a *ApplicationDeployAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *ApplicationDeployAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config ApplicationDeployActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := actionTimeout(config.Timeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+		return
+	}
+
+	appID := config.ApplicationID.ValueString()
+	wait := config.WaitForDeployment.ValueBool()
+	previousID := ""
+	if wait {
+		previousID = a.client.LatestApplicationDeploymentID(appID)
+	}
+
+	if err := a.client.DeployApplication(appID); err != nil {
+		resp.Diagnostics.AddError("Error deploying application", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Deployment of application %s triggered", appID)})
+
+	if wait {
+		resp.SendProgress(action.InvokeProgressEvent{Message: "Waiting for deployment to finish"})
+		waitForDeployment(ctx, a.client, "application", appID, previousID, timeout, &resp.Diagnostics)
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ action.Action = &ApplicationRollbackAction{}
+var _ action.ActionWithConfigure = &ApplicationRollbackAction{}
+
+func NewApplicationRollbackAction() action.Action {
+	return &ApplicationRollbackAction{}
+}
+
+type ApplicationRollbackAction struct {
+	client *client.DokployClient
+}
+
+type ApplicationRollbackActionModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+	DeploymentID  types.String `tfsdk:"deployment_id"`
+}
+
+func (a *ApplicationRollbackAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_rollback"
+}
+
+func (a *ApplicationRollbackAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rolls a Dokploy application back to the image of a previous deployment. Requires rollbacks to have been enabled when that deployment ran.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+			"deployment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Deployment to restore, as listed by the dokploy_deployments data source.",
+			},
+		},
+	}
+}
+
+func (a *ApplicationRollbackAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *ApplicationRollbackAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config ApplicationRollbackActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := a.client.RollbackApplication(config.ApplicationID.ValueString(), config.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error rolling back application", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Application %s rolled back to deployment %q", config.ApplicationID.ValueString(), deployment.Title)})
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ action.Action = &ApplicationStopAction{}
+var _ action.ActionWithConfigure = &ApplicationStopAction{}
+
+func NewApplicationStopAction() action.Action {
+	return &ApplicationStopAction{}
+}
+
+type ApplicationStopAction struct {
+	client *client.DokployClient
+}
+
+type ApplicationStopActionModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+}
+
+func (a *ApplicationStopAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_stop"
+}
+
+func (a *ApplicationStopAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Stops the running containers of a Dokploy application.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (a *ApplicationStopAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *ApplicationStopAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config ApplicationStopActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.client.StopApplication(config.ApplicationID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error stopping application", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Application %s stopped", config.ApplicationID.ValueString())})
+}
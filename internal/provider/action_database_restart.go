@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ action.Action = &DatabaseRestartAction{}
+var _ action.ActionWithConfigure = &DatabaseRestartAction{}
+
+func NewDatabaseRestartAction() action.Action {
+	return &DatabaseRestartAction{}
+}
+
+type DatabaseRestartAction struct {
+	client *client.DokployClient
+}
+
+type DatabaseRestartActionModel struct {
+	DatabaseID types.String `tfsdk:"database_id"`
+	Type       types.String `tfsdk:"type"`
+}
+
+func (a *DatabaseRestartAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_restart"
+}
+
+func (a *DatabaseRestartAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts a Dokploy database service.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Required: true,
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Database engine: postgres, mysql, mariadb, mongo or redis.",
+			},
+		},
+	}
+}
+
+func (a *DatabaseRestartAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *DatabaseRestartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config DatabaseRestartActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbType := strings.ToLower(strings.TrimSpace(config.Type.ValueString()))
+	switch dbType {
+	case "postgres", "mysql", "mariadb", "mongo", "redis":
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Database Type", fmt.Sprintf("Unsupported database type %q. Expected one of postgres, mysql, mariadb, mongo or redis.", config.Type.ValueString()))
+		return
+	}
+
+	if err := a.client.RestartDatabase(config.DatabaseID.ValueString(), dbType); err != nil {
+		resp.Diagnostics.AddError("Error restarting database", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Database %s restarted", config.DatabaseID.ValueString())})
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ action.Action = &TraefikReloadAction{}
+var _ action.ActionWithConfigure = &TraefikReloadAction{}
+
+func NewTraefikReloadAction() action.Action {
+	return &TraefikReloadAction{}
+}
+
+type TraefikReloadAction struct {
+	client *client.DokployClient
+}
+
+type TraefikReloadActionModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+}
+
+func (a *TraefikReloadAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traefik_reload"
+}
+
+func (a *TraefikReloadAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reloads Traefik on the Dokploy host or on a remote server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Remote server to reload Traefik on. Defaults to the Dokploy host.",
+			},
+		},
+	}
+}
+
+func (a *TraefikReloadAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *TraefikReloadAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config TraefikReloadActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.client.ReloadTraefik(config.ServerID.ValueStringPointer()); err != nil {
+		resp.Diagnostics.AddError("Error reloading Traefik", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Traefik reloaded"})
+}
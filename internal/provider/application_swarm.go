@@ -0,0 +1,637 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+// Defaults Docker applies to update and rollback configs. They are not
+// written back to state unless configured, so omitting them does not diff.
+const (
+	defaultSwarmUpdateOrder         = "stop-first"
+	defaultSwarmUpdateFailureAction = "pause"
+)
+
+type swarmHealthCheckModel struct {
+	Test        types.List   `tfsdk:"test"`
+	Interval    types.String `tfsdk:"interval"`
+	Timeout     types.String `tfsdk:"timeout"`
+	StartPeriod types.String `tfsdk:"start_period"`
+	Retries     types.Int64  `tfsdk:"retries"`
+}
+
+type swarmRestartPolicyModel struct {
+	Condition   types.String `tfsdk:"condition"`
+	Delay       types.String `tfsdk:"delay"`
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	Window      types.String `tfsdk:"window"`
+}
+
+type swarmPlacementModel struct {
+	Constraints types.List  `tfsdk:"constraints"`
+	Preferences types.List  `tfsdk:"preferences"`
+	MaxReplicas types.Int64 `tfsdk:"max_replicas"`
+}
+
+type swarmUpdateConfigModel struct {
+	Parallelism     types.Int64   `tfsdk:"parallelism"`
+	Delay           types.String  `tfsdk:"delay"`
+	FailureAction   types.String  `tfsdk:"failure_action"`
+	Monitor         types.String  `tfsdk:"monitor"`
+	MaxFailureRatio types.Float64 `tfsdk:"max_failure_ratio"`
+	Order           types.String  `tfsdk:"order"`
+}
+
+type swarmModeModel struct {
+	Type     types.String `tfsdk:"type"`
+	Replicas types.Int64  `tfsdk:"replicas"`
+}
+
+type swarmNetworkModel struct {
+	Target     types.String `tfsdk:"target"`
+	Aliases    types.List   `tfsdk:"aliases"`
+	DriverOpts types.Map    `tfsdk:"driver_opts"`
+}
+
+var swarmHealthCheckAttrTypes = map[string]attr.Type{
+	"test":         types.ListType{ElemType: types.StringType},
+	"interval":     types.StringType,
+	"timeout":      types.StringType,
+	"start_period": types.StringType,
+	"retries":      types.Int64Type,
+}
+
+var swarmRestartPolicyAttrTypes = map[string]attr.Type{
+	"condition":    types.StringType,
+	"delay":        types.StringType,
+	"max_attempts": types.Int64Type,
+	"window":       types.StringType,
+}
+
+var swarmPlacementAttrTypes = map[string]attr.Type{
+	"constraints":  types.ListType{ElemType: types.StringType},
+	"preferences":  types.ListType{ElemType: types.StringType},
+	"max_replicas": types.Int64Type,
+}
+
+var swarmUpdateConfigAttrTypes = map[string]attr.Type{
+	"parallelism":       types.Int64Type,
+	"delay":             types.StringType,
+	"failure_action":    types.StringType,
+	"monitor":           types.StringType,
+	"max_failure_ratio": types.Float64Type,
+	"order":             types.StringType,
+}
+
+var swarmModeAttrTypes = map[string]attr.Type{
+	"type":     types.StringType,
+	"replicas": types.Int64Type,
+}
+
+var swarmNetworkAttrTypes = map[string]attr.Type{
+	"target":      types.StringType,
+	"aliases":     types.ListType{ElemType: types.StringType},
+	"driver_opts": types.MapType{ElemType: types.StringType},
+}
+
+var swarmNetworkObjectType = types.ObjectType{
+	AttrTypes: swarmNetworkAttrTypes,
+}
+
+const swarmDurationDescription = "Go duration string, e.g. 30s or 1m30s."
+
+func swarmUpdateConfigSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"parallelism": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of tasks changed at the same time. 0 changes all tasks at once.",
+			},
+			"delay": schema.StringAttribute{
+				Optional:    true,
+				Description: "Delay between batches. " + swarmDurationDescription,
+			},
+			"failure_action": schema.StringAttribute{
+				Optional:    true,
+				Description: "Action on failure: pause, continue or rollback. Defaults to pause.",
+			},
+			"monitor": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time to monitor each task for failure. " + swarmDurationDescription,
+			},
+			"max_failure_ratio": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Fraction of tasks that may fail before the failure action is taken, between 0 and 1.",
+			},
+			"order": schema.StringAttribute{
+				Optional:    true,
+				Description: "Operation order: stop-first or start-first. Defaults to stop-first.",
+			},
+		},
+	}
+}
+
+func applicationSwarmSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"swarm_health_check": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Swarm health check for the application's containers.",
+			Attributes: map[string]schema.Attribute{
+				"test": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Health check command, e.g. [\"CMD-SHELL\", \"curl -f http://localhost/ || exit 1\"].",
+				},
+				"interval": schema.StringAttribute{
+					Optional:    true,
+					Description: "Time between checks. " + swarmDurationDescription,
+				},
+				"timeout": schema.StringAttribute{
+					Optional:    true,
+					Description: "Time to wait before a check is considered hung. " + swarmDurationDescription,
+				},
+				"start_period": schema.StringAttribute{
+					Optional:    true,
+					Description: "Start period during which failures are not counted. " + swarmDurationDescription,
+				},
+				"retries": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Consecutive failures needed to report the container unhealthy.",
+				},
+			},
+		},
+		"swarm_restart_policy": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Swarm restart policy for the application's tasks.",
+			Attributes: map[string]schema.Attribute{
+				"condition": schema.StringAttribute{
+					Optional:    true,
+					Description: "Restart condition: none, on-failure or any.",
+				},
+				"delay": schema.StringAttribute{
+					Optional:    true,
+					Description: "Delay between restart attempts. " + swarmDurationDescription,
+				},
+				"max_attempts": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum restart attempts before giving up.",
+				},
+				"window": schema.StringAttribute{
+					Optional:    true,
+					Description: "Window used to evaluate the restart policy. " + swarmDurationDescription,
+				},
+			},
+		},
+		"swarm_placement": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Swarm placement rules for the application's tasks.",
+			Attributes: map[string]schema.Attribute{
+				"constraints": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Placement constraints, e.g. node.role==manager.",
+				},
+				"preferences": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Spread descriptors tasks are spread over, e.g. node.labels.zone.",
+				},
+				"max_replicas": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum number of tasks per node.",
+				},
+			},
+		},
+		"swarm_update_config":   swarmUpdateConfigSchema("How Swarm rolls out updates to the application."),
+		"swarm_rollback_config": swarmUpdateConfigSchema("How Swarm rolls the application back after a failed update."),
+		"swarm_mode": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Swarm service mode.",
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "Service mode: replicated or global.",
+				},
+				"replicas": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Number of replicas. Only valid for the replicated mode.",
+				},
+			},
+		},
+		"swarm_networks": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Additional Swarm networks the application is attached to.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"target": schema.StringAttribute{
+						Required:    true,
+						Description: "Name or ID of the network.",
+					},
+					"aliases": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Network aliases for the application.",
+					},
+					"driver_opts": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Network driver options.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateApplicationSwarm checks enum and duration values of the swarm
+// settings. Unknown values are skipped.
+func validateApplicationSwarm(ctx context.Context, config ApplicationResourceModel, diags *diag.Diagnostics) {
+	if object := config.SwarmHealthCheck; !object.IsNull() && !object.IsUnknown() {
+		var model swarmHealthCheckModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		root := path.Root("swarm_health_check")
+		validateSwarmDuration(model.Interval, root.AtName("interval"), diags)
+		validateSwarmDuration(model.Timeout, root.AtName("timeout"), diags)
+		validateSwarmDuration(model.StartPeriod, root.AtName("start_period"), diags)
+	}
+
+	if object := config.SwarmRestartPolicy; !object.IsNull() && !object.IsUnknown() {
+		var model swarmRestartPolicyModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		root := path.Root("swarm_restart_policy")
+		validateSwarmDuration(model.Delay, root.AtName("delay"), diags)
+		validateSwarmDuration(model.Window, root.AtName("window"), diags)
+		validateSwarmOneOf(model.Condition, root.AtName("condition"), diags, "none", "on-failure", "any")
+	}
+
+	for name, object := range map[string]types.Object{
+		"swarm_update_config":   config.SwarmUpdateConfig,
+		"swarm_rollback_config": config.SwarmRollbackConfig,
+	} {
+		if object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		var model swarmUpdateConfigModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		root := path.Root(name)
+		validateSwarmDuration(model.Delay, root.AtName("delay"), diags)
+		validateSwarmDuration(model.Monitor, root.AtName("monitor"), diags)
+		validateSwarmOneOf(model.FailureAction, root.AtName("failure_action"), diags, "pause", "continue", "rollback")
+		validateSwarmOneOf(model.Order, root.AtName("order"), diags, "stop-first", "start-first")
+		if !model.Parallelism.IsNull() && !model.Parallelism.IsUnknown() && model.Parallelism.ValueInt64() < 0 {
+			diags.AddAttributeError(root.AtName("parallelism"), "Invalid Parallelism", "parallelism must be greater than or equal to 0.")
+		}
+		if ratio := model.MaxFailureRatio; !ratio.IsNull() && !ratio.IsUnknown() && (ratio.ValueFloat64() < 0 || ratio.ValueFloat64() > 1) {
+			diags.AddAttributeError(root.AtName("max_failure_ratio"), "Invalid Max Failure Ratio", "max_failure_ratio must be between 0 and 1.")
+		}
+	}
+
+	if object := config.SwarmMode; !object.IsNull() && !object.IsUnknown() {
+		var model swarmModeModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		root := path.Root("swarm_mode")
+		validateSwarmOneOf(model.Type, root.AtName("type"), diags, "replicated", "global")
+		if model.Type.ValueString() == "global" && !model.Replicas.IsNull() {
+			diags.AddAttributeError(root.AtName("replicas"), "Invalid Swarm Mode", "replicas can only be set for the replicated mode.")
+		}
+	}
+}
+
+func validateSwarmDuration(value types.String, attributePath path.Path, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(value.ValueString()))
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attributePath, "Invalid Duration", fmt.Sprintf("%q is not a valid non-negative duration. Use a Go duration string such as 30s or 1m30s.", value.ValueString()))
+	}
+}
+
+func validateSwarmOneOf(value types.String, attributePath path.Path, diags *diag.Diagnostics, allowed ...string) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	for _, candidate := range allowed {
+		if value.ValueString() == candidate {
+			return
+		}
+	}
+	diags.AddAttributeError(attributePath, "Invalid Value", fmt.Sprintf("%q must be one of: %s.", value.ValueString(), strings.Join(allowed, ", ")))
+}
+
+// applicationSwarmFromPlan copies the configured swarm settings onto app.
+func applicationSwarmFromPlan(ctx context.Context, plan ApplicationResourceModel, app *client.Application) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if object := plan.SwarmHealthCheck; !object.IsNull() && !object.IsUnknown() {
+		var model swarmHealthCheckModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		test, listDiags := swarmStringsFromPlan(ctx, model.Test)
+		diags.Append(listDiags...)
+		app.HealthCheckSwarm = &client.HealthCheckSwarm{
+			Test:        test,
+			Interval:    swarmDurationFromPlan(model.Interval),
+			Timeout:     swarmDurationFromPlan(model.Timeout),
+			StartPeriod: swarmDurationFromPlan(model.StartPeriod),
+			Retries:     optionalInt64PointerFromPlan(model.Retries),
+		}
+	}
+
+	if object := plan.SwarmRestartPolicy; !object.IsNull() && !object.IsUnknown() {
+		var model swarmRestartPolicyModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		app.RestartPolicySwarm = &client.RestartPolicySwarm{
+			Condition:   optionalStringFromPlan(model.Condition),
+			Delay:       swarmDurationFromPlan(model.Delay),
+			MaxAttempts: optionalInt64PointerFromPlan(model.MaxAttempts),
+			Window:      swarmDurationFromPlan(model.Window),
+		}
+	}
+
+	if object := plan.SwarmPlacement; !object.IsNull() && !object.IsUnknown() {
+		var model swarmPlacementModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		constraints, listDiags := swarmStringsFromPlan(ctx, model.Constraints)
+		diags.Append(listDiags...)
+		spreads, listDiags := swarmStringsFromPlan(ctx, model.Preferences)
+		diags.Append(listDiags...)
+		placement := &client.PlacementSwarm{
+			Constraints: constraints,
+			MaxReplicas: optionalInt64PointerFromPlan(model.MaxReplicas),
+		}
+		for _, spread := range spreads {
+			placement.Preferences = append(placement.Preferences, client.PlacementPreferenceSwarm{
+				Spread: client.SpreadOverSwarm{SpreadDescriptor: spread},
+			})
+		}
+		app.PlacementSwarm = placement
+	}
+
+	var updateDiags diag.Diagnostics
+	app.UpdateConfigSwarm, updateDiags = swarmUpdateConfigFromPlan(ctx, plan.SwarmUpdateConfig)
+	diags.Append(updateDiags...)
+	app.RollbackConfigSwarm, updateDiags = swarmUpdateConfigFromPlan(ctx, plan.SwarmRollbackConfig)
+	diags.Append(updateDiags...)
+
+	if object := plan.SwarmMode; !object.IsNull() && !object.IsUnknown() {
+		var model swarmModeModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if model.Type.ValueString() == "global" {
+			app.ModeSwarm = &client.ModeSwarm{Global: &client.GlobalModeSwarm{}}
+		} else {
+			app.ModeSwarm = &client.ModeSwarm{Replicated: &client.ReplicatedModeSwarm{
+				Replicas: optionalInt64PointerFromPlan(model.Replicas),
+			}}
+		}
+	}
+
+	if list := plan.SwarmNetworks; !list.IsNull() && !list.IsUnknown() {
+		var models []swarmNetworkModel
+		diags.Append(list.ElementsAs(ctx, &models, false)...)
+		networks := make([]client.NetworkSwarm, 0, len(models))
+		for _, model := range models {
+			aliases, listDiags := swarmStringsFromPlan(ctx, model.Aliases)
+			diags.Append(listDiags...)
+			driverOpts, mapDiags := optionalStringMapFromPlan(ctx, model.DriverOpts)
+			diags.Append(mapDiags...)
+			networks = append(networks, client.NetworkSwarm{
+				Target:     model.Target.ValueString(),
+				Aliases:    aliases,
+				DriverOpts: driverOpts,
+			})
+		}
+		app.NetworkSwarm = networks
+	}
+
+	return diags
+}
+
+func swarmUpdateConfigFromPlan(ctx context.Context, object types.Object) (*client.UpdateConfigSwarm, diag.Diagnostics) {
+	if object.IsNull() || object.IsUnknown() {
+		return nil, nil
+	}
+	var model swarmUpdateConfigModel
+	diags := object.As(ctx, &model, basetypes.ObjectAsOptions{})
+	config := &client.UpdateConfigSwarm{
+		Parallelism:   model.Parallelism.ValueInt64(),
+		Delay:         swarmDurationFromPlan(model.Delay),
+		FailureAction: optionalStringFromPlan(model.FailureAction),
+		Monitor:       swarmDurationFromPlan(model.Monitor),
+		Order:         optionalStringFromPlan(model.Order),
+	}
+	if !model.MaxFailureRatio.IsNull() && !model.MaxFailureRatio.IsUnknown() {
+		ratio := model.MaxFailureRatio.ValueFloat64()
+		config.MaxFailureRatio = &ratio
+	}
+	if config.Order == "" {
+		config.Order = defaultSwarmUpdateOrder
+	}
+	return config, diags
+}
+
+func swarmDurationFromPlan(value types.String) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(value.ValueString()))
+	if err != nil {
+		return nil
+	}
+	nanoseconds := duration.Nanoseconds()
+	return &nanoseconds
+}
+
+func swarmStringsFromPlan(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	var values []string
+	diags := list.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// refreshApplicationSwarmState updates the swarm settings from the values
+// Dokploy reports. Values equal to the configured ones, such as 30s and
+// 30000ms, keep their configured spelling so they do not produce diffs.
+func refreshApplicationSwarmState(ctx context.Context, state *ApplicationResourceModel, app *client.Application) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if healthCheck := app.HealthCheckSwarm; healthCheck != nil {
+		var current swarmHealthCheckModel
+		diags.Append(state.SwarmHealthCheck.As(ctx, &current, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
+		state.SwarmHealthCheck = swarmObjectValue(ctx, &diags, swarmHealthCheckAttrTypes, swarmHealthCheckModel{
+			Test:        swarmStringsState(current.Test, healthCheck.Test),
+			Interval:    swarmDurationState(current.Interval, healthCheck.Interval),
+			Timeout:     swarmDurationState(current.Timeout, healthCheck.Timeout),
+			StartPeriod: swarmDurationState(current.StartPeriod, healthCheck.StartPeriod),
+			Retries:     swarmInt64State(healthCheck.Retries),
+		})
+	} else {
+		state.SwarmHealthCheck = types.ObjectNull(swarmHealthCheckAttrTypes)
+	}
+
+	if restartPolicy := app.RestartPolicySwarm; restartPolicy != nil {
+		var current swarmRestartPolicyModel
+		diags.Append(state.SwarmRestartPolicy.As(ctx, &current, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
+		state.SwarmRestartPolicy = swarmObjectValue(ctx, &diags, swarmRestartPolicyAttrTypes, swarmRestartPolicyModel{
+			Condition:   swarmStringState(current.Condition, restartPolicy.Condition, ""),
+			Delay:       swarmDurationState(current.Delay, restartPolicy.Delay),
+			MaxAttempts: swarmInt64State(restartPolicy.MaxAttempts),
+			Window:      swarmDurationState(current.Window, restartPolicy.Window),
+		})
+	} else {
+		state.SwarmRestartPolicy = types.ObjectNull(swarmRestartPolicyAttrTypes)
+	}
+
+	if placement := app.PlacementSwarm; placement != nil {
+		var current swarmPlacementModel
+		diags.Append(state.SwarmPlacement.As(ctx, &current, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
+		spreads := make([]string, 0, len(placement.Preferences))
+		for _, preference := range placement.Preferences {
+			spreads = append(spreads, preference.Spread.SpreadDescriptor)
+		}
+		state.SwarmPlacement = swarmObjectValue(ctx, &diags, swarmPlacementAttrTypes, swarmPlacementModel{
+			Constraints: swarmStringsState(current.Constraints, placement.Constraints),
+			Preferences: swarmStringsState(current.Preferences, spreads),
+			MaxReplicas: swarmInt64State(placement.MaxReplicas),
+		})
+	} else {
+		state.SwarmPlacement = types.ObjectNull(swarmPlacementAttrTypes)
+	}
+
+	var updateDiags diag.Diagnostics
+	state.SwarmUpdateConfig, updateDiags = swarmUpdateConfigState(ctx, state.SwarmUpdateConfig, app.UpdateConfigSwarm)
+	diags.Append(updateDiags...)
+	state.SwarmRollbackConfig, updateDiags = swarmUpdateConfigState(ctx, state.SwarmRollbackConfig, app.RollbackConfigSwarm)
+	diags.Append(updateDiags...)
+
+	mode := app.ModeSwarm
+	switch {
+	case mode != nil && mode.Global != nil:
+		state.SwarmMode = swarmObjectValue(ctx, &diags, swarmModeAttrTypes, swarmModeModel{
+			Type:     types.StringValue("global"),
+			Replicas: types.Int64Null(),
+		})
+	case mode != nil && mode.Replicated != nil:
+		state.SwarmMode = swarmObjectValue(ctx, &diags, swarmModeAttrTypes, swarmModeModel{
+			Type:     types.StringValue("replicated"),
+			Replicas: swarmInt64State(mode.Replicated.Replicas),
+		})
+	default:
+		state.SwarmMode = types.ObjectNull(swarmModeAttrTypes)
+	}
+
+	if len(app.NetworkSwarm) > 0 {
+		var current []swarmNetworkModel
+		if !state.SwarmNetworks.IsNull() {
+			diags.Append(state.SwarmNetworks.ElementsAs(ctx, &current, false)...)
+		}
+		values := make([]attr.Value, 0, len(app.NetworkSwarm))
+		for i, network := range app.NetworkSwarm {
+			reference := swarmNetworkModel{
+				Aliases:    types.ListNull(types.StringType),
+				DriverOpts: types.MapNull(types.StringType),
+			}
+			if i < len(current) {
+				reference = current[i]
+			}
+			driverOpts := reference.DriverOpts
+			if len(network.DriverOpts) > 0 || !driverOpts.IsNull() {
+				var mapDiags diag.Diagnostics
+				driverOpts, mapDiags = types.MapValueFrom(ctx, types.StringType, network.DriverOpts)
+				diags.Append(mapDiags...)
+			}
+			object, objectDiags := types.ObjectValueFrom(ctx, swarmNetworkAttrTypes, swarmNetworkModel{
+				Target:     types.StringValue(network.Target),
+				Aliases:    swarmStringsState(reference.Aliases, network.Aliases),
+				DriverOpts: driverOpts,
+			})
+			diags.Append(objectDiags...)
+			values = append(values, object)
+		}
+		list, listDiags := types.ListValue(swarmNetworkObjectType, values)
+		diags.Append(listDiags...)
+		state.SwarmNetworks = list
+	} else {
+		state.SwarmNetworks = types.ListNull(swarmNetworkObjectType)
+	}
+
+	return diags
+}
+
+func swarmUpdateConfigState(ctx context.Context, current types.Object, config *client.UpdateConfigSwarm) (types.Object, diag.Diagnostics) {
+	if config == nil {
+		return types.ObjectNull(swarmUpdateConfigAttrTypes), nil
+	}
+	var model swarmUpdateConfigModel
+	diags := current.As(ctx, &model, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})
+	maxFailureRatio := types.Float64Null()
+	if config.MaxFailureRatio != nil {
+		maxFailureRatio = types.Float64Value(*config.MaxFailureRatio)
+	}
+	return swarmObjectValue(ctx, &diags, swarmUpdateConfigAttrTypes, swarmUpdateConfigModel{
+		Parallelism:     types.Int64Value(config.Parallelism),
+		Delay:           swarmDurationState(model.Delay, config.Delay),
+		FailureAction:   swarmStringState(model.FailureAction, config.FailureAction, defaultSwarmUpdateFailureAction),
+		Monitor:         swarmDurationState(model.Monitor, config.Monitor),
+		MaxFailureRatio: maxFailureRatio,
+		Order:           swarmStringState(model.Order, config.Order, defaultSwarmUpdateOrder),
+	}), diags
+}
+
+func swarmObjectValue(ctx context.Context, diags *diag.Diagnostics, attrTypes map[string]attr.Type, model any) types.Object {
+	object, objectDiags := types.ObjectValueFrom(ctx, attrTypes, model)
+	diags.Append(objectDiags...)
+	return object
+}
+
+// swarmDurationState keeps the configured duration when it is equivalent to
+// the reported one, and otherwise renders the reported nanoseconds.
+func swarmDurationState(current types.String, nanoseconds *int64) types.String {
+	if nanoseconds == nil {
+		return types.StringNull()
+	}
+	if configured := swarmDurationFromPlan(current); configured != nil && *configured == *nanoseconds {
+		return current
+	}
+	return types.StringValue(time.Duration(*nanoseconds).String())
+}
+
+// swarmStringState leaves an unset attribute null when Dokploy reports
+// nothing or the Docker default.
+func swarmStringState(current types.String, value, defaultValue string) types.String {
+	if value == "" || (current.IsNull() && value == defaultValue) {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func swarmInt64State(value *int64) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*value)
+}
+
+func swarmStringsState(current types.List, values []string) types.List {
+	if len(values) == 0 && current.IsNull() {
+		return current
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func swarmTestModel(t *testing.T) ApplicationResourceModel {
+	t.Helper()
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	model := ApplicationResourceModel{
+		SwarmHealthCheck: swarmObjectValue(ctx, &diags, swarmHealthCheckAttrTypes, swarmHealthCheckModel{
+			Test:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("CMD-SHELL"), types.StringValue("curl -f http://localhost/")}),
+			Interval:    types.StringValue("30s"),
+			Timeout:     types.StringNull(),
+			StartPeriod: types.StringValue("1m"),
+			Retries:     types.Int64Value(3),
+		}),
+		SwarmRestartPolicy: types.ObjectNull(swarmRestartPolicyAttrTypes),
+		SwarmPlacement: swarmObjectValue(ctx, &diags, swarmPlacementAttrTypes, swarmPlacementModel{
+			Constraints: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("node.role==manager")}),
+			Preferences: types.ListNull(types.StringType),
+			MaxReplicas: types.Int64Null(),
+		}),
+		SwarmUpdateConfig: swarmObjectValue(ctx, &diags, swarmUpdateConfigAttrTypes, swarmUpdateConfigModel{
+			Parallelism:     types.Int64Value(2),
+			Delay:           types.StringValue("10s"),
+			FailureAction:   types.StringNull(),
+			Monitor:         types.StringNull(),
+			MaxFailureRatio: types.Float64Null(),
+			Order:           types.StringNull(),
+		}),
+		SwarmRollbackConfig: types.ObjectNull(swarmUpdateConfigAttrTypes),
+		SwarmMode: swarmObjectValue(ctx, &diags, swarmModeAttrTypes, swarmModeModel{
+			Type:     types.StringValue("global"),
+			Replicas: types.Int64Null(),
+		}),
+		SwarmNetworks: types.ListNull(swarmNetworkObjectType),
+	}
+	if diags.HasError() {
+		t.Fatalf("failed to build model: %v", diags)
+	}
+	return model
+}
+
+func TestApplicationSwarmFromPlan(t *testing.T) {
+	var app client.Application
+	if diags := applicationSwarmFromPlan(context.Background(), swarmTestModel(t), &app); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"healthCheckSwarm":  app.HealthCheckSwarm,
+		"placementSwarm":    app.PlacementSwarm,
+		"updateConfigSwarm": app.UpdateConfigSwarm,
+		"modeSwarm":         app.ModeSwarm,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal swarm settings: %v", err)
+	}
+	expected := `{"healthCheckSwarm":{"Test":["CMD-SHELL","curl -f http://localhost/"],"Interval":30000000000,"StartPeriod":60000000000,"Retries":3},` +
+		`"modeSwarm":{"Global":{}},` +
+		`"placementSwarm":{"Constraints":["node.role==manager"]},` +
+		`"updateConfigSwarm":{"Parallelism":2,"Delay":10000000000,"Order":"stop-first"}}`
+	if string(payload) != expected {
+		t.Fatalf("unexpected payload:\n got %s\nwant %s", payload, expected)
+	}
+	if app.RestartPolicySwarm != nil || app.RollbackConfigSwarm != nil || app.NetworkSwarm != nil {
+		t.Fatalf("unset settings should stay nil: %#v", app)
+	}
+}
+
+func TestRefreshApplicationSwarmState_KeepsEquivalentValues(t *testing.T) {
+	ctx := context.Background()
+	state := swarmTestModel(t)
+	var app client.Application
+	if diags := applicationSwarmFromPlan(ctx, state, &app); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Dokploy echoes the settings back, with Docker defaults filled in.
+	app.UpdateConfigSwarm.FailureAction = "pause"
+	app.PlacementSwarm.Preferences = []client.PlacementPreferenceSwarm{}
+
+	refreshed := state
+	if diags := refreshApplicationSwarmState(ctx, &refreshed, &app); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !refreshed.SwarmHealthCheck.Equal(state.SwarmHealthCheck) {
+		t.Fatalf("health check drifted: %s", refreshed.SwarmHealthCheck)
+	}
+	if !refreshed.SwarmPlacement.Equal(state.SwarmPlacement) {
+		t.Fatalf("placement drifted: %s", refreshed.SwarmPlacement)
+	}
+	if !refreshed.SwarmUpdateConfig.Equal(state.SwarmUpdateConfig) {
+		t.Fatalf("update config drifted: %s", refreshed.SwarmUpdateConfig)
+	}
+	if !refreshed.SwarmMode.Equal(state.SwarmMode) {
+		t.Fatalf("mode drifted: %s", refreshed.SwarmMode)
+	}
+}
+
+func TestRefreshApplicationSwarmState_ReportsDrift(t *testing.T) {
+	ctx := context.Background()
+	state := swarmTestModel(t)
+	interval := int64(45_000_000_000)
+	replicas := int64(3)
+	app := client.Application{
+		HealthCheckSwarm: &client.HealthCheckSwarm{Interval: &interval},
+		ModeSwarm:        &client.ModeSwarm{Replicated: &client.ReplicatedModeSwarm{Replicas: &replicas}},
+	}
+
+	if diags := refreshApplicationSwarmState(ctx, &state, &app); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var healthCheck swarmHealthCheckModel
+	state.SwarmHealthCheck.As(ctx, &healthCheck, basetypes.ObjectAsOptions{})
+	if healthCheck.Interval.ValueString() != "45s" || !healthCheck.Retries.IsNull() {
+		t.Fatalf("unexpected health check: %#v", healthCheck)
+	}
+	if !state.SwarmPlacement.IsNull() {
+		t.Fatalf("expected removed placement to be null, got %s", state.SwarmPlacement)
+	}
+	var mode swarmModeModel
+	state.SwarmMode.As(ctx, &mode, basetypes.ObjectAsOptions{})
+	if mode.Type.ValueString() != "replicated" || mode.Replicas.ValueInt64() != 3 {
+		t.Fatalf("unexpected mode: %#v", mode)
+	}
+}
+
+func TestRefreshApplicationSwarmState_ReportsUnconfiguredSettings(t *testing.T) {
+	ctx := context.Background()
+	state := swarmTestModel(t)
+	condition := "on-failure"
+	app := client.Application{
+		RestartPolicySwarm: &client.RestartPolicySwarm{Condition: condition},
+		NetworkSwarm:       []client.NetworkSwarm{{Target: "backend"}},
+	}
+
+	if diags := refreshApplicationSwarmState(ctx, &state, &app); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var restartPolicy swarmRestartPolicyModel
+	state.SwarmRestartPolicy.As(ctx, &restartPolicy, basetypes.ObjectAsOptions{})
+	if restartPolicy.Condition.ValueString() != condition {
+		t.Fatalf("expected restart policy set in Dokploy to be reported, got %s", state.SwarmRestartPolicy)
+	}
+	if len(state.SwarmNetworks.Elements()) != 1 {
+		t.Fatalf("expected networks set in Dokploy to be reported, got %s", state.SwarmNetworks)
+	}
+	if !state.SwarmHealthCheck.IsNull() {
+		t.Fatalf("expected health check removed in Dokploy to be null, got %s", state.SwarmHealthCheck)
+	}
+}
@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+	"gopkg.in/yaml.v3"
+)
+
+// composeFileNames lists the services and named volumes a compose file
+// defines.
+type composeFileNames struct {
+	Services []string
+	Volumes  []string
+	// Partial is set when the file includes other compose files, whose
+	// services and volumes are not known.
+	Partial bool
+	// AppName is set by deployedComposeFile to the app name Dokploy prefixes
+	// volume names with.
+	AppName string
+}
+
+// parseComposeFile extracts the service and volume names of a compose file.
+// Volumes are listed under their key and, when set, their explicit name.
+func parseComposeFile(content string) (composeFileNames, error) {
+	var file struct {
+		Include  []interface{}          `yaml:"include"`
+		Services map[string]interface{} `yaml:"services"`
+		// Volume definitions are usually mappings, but may be left empty or
+		// written as "".
+		Volumes map[string]interface{} `yaml:"volumes"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &file); err != nil {
+		return composeFileNames{}, err
+	}
+
+	names := composeFileNames{
+		Services: make([]string, 0, len(file.Services)),
+		Volumes:  make([]string, 0, len(file.Volumes)),
+		Partial:  len(file.Include) > 0,
+	}
+	for service := range file.Services {
+		names.Services = append(names.Services, service)
+	}
+	for key, volume := range file.Volumes {
+		names.Volumes = append(names.Volumes, key)
+		if definition, ok := volume.(map[string]interface{}); ok {
+			if name, ok := definition["name"].(string); ok && name != "" && name != key {
+				names.Volumes = append(names.Volumes, name)
+			}
+		}
+	}
+	sort.Strings(names.Services)
+	sort.Strings(names.Volumes)
+	return names, nil
+}
+
+// composeFileState returns the services and volumes attributes for a compose
+// file. Unknown content yields unknown lists and empty content null lists.
+// Unparsable content yields null lists and a warning: the content may come
+// from Dokploy, and failing on it would block every refresh. ModifyPlan
+// rejects configured content that cannot be parsed.
+func composeFileState(ctx context.Context, content types.String) (types.List, types.List, diag.Diagnostics) {
+	if content.IsUnknown() {
+		return types.ListUnknown(types.StringType), types.ListUnknown(types.StringType), nil
+	}
+	if strings.TrimSpace(content.ValueString()) == "" {
+		return types.ListNull(types.StringType), types.ListNull(types.StringType), nil
+	}
+
+	var diags diag.Diagnostics
+	names, err := parseComposeFile(content.ValueString())
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("compose_file_content"),
+			"Unreadable Compose File",
+			fmt.Sprintf("The services and volumes of the compose file could not be read, so they are left empty: %s", err),
+		)
+		return types.ListNull(types.StringType), types.ListNull(types.StringType), diags
+	}
+
+	services, d := types.ListValueFrom(ctx, types.StringType, names.Services)
+	diags.Append(d...)
+	volumes, d := types.ListValueFrom(ctx, types.StringType, names.Volumes)
+	diags.Append(d...)
+	return services, volumes, diags
+}
+
+// deployedComposeFile returns the names defined by the compose file Dokploy
+// stores for composeID. It returns nil when there is nothing reliable to
+// check against: the ID is not known yet, the lookup fails, or the file is
+// empty, invalid or includes other files.
+func deployedComposeFile(c *client.DokployClient, composeID types.String) *composeFileNames {
+	if c == nil || composeID.IsNull() || composeID.IsUnknown() || composeID.ValueString() == "" {
+		return nil
+	}
+	comp, err := c.GetCompose(composeID.ValueString())
+	if err != nil || strings.TrimSpace(comp.ComposeFile) == "" {
+		return nil
+	}
+	names, err := parseComposeFile(comp.ComposeFile)
+	if err != nil || names.Partial {
+		return nil
+	}
+	names.AppName = comp.AppName
+	return &names
+}
+
+// declaredComposeNames returns the names passed in a compose_services or
+// compose_volumes attribute. ok is false when the list is null or not known
+// yet, in which case there is nothing to check against.
+func declaredComposeNames(ctx context.Context, list types.List) (names []string, ok bool, diags diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, false, nil
+	}
+	diags = list.ElementsAs(ctx, &names, false)
+	return names, !diags.HasError(), diags
+}
+
+// warnUnknownComposeName reports a service or volume that the compose file
+// does not define. It is a warning because the compose file may gain the
+// name in the same apply.
+func warnUnknownComposeName(diags *diag.Diagnostics, attribute, kind string, name types.String, defined []string, composeID string) {
+	if !composeNameUnknown(name, defined) {
+		return
+	}
+	diags.AddAttributeWarning(
+		path.Root(attribute),
+		fmt.Sprintf("Unknown Compose %s", kind),
+		fmt.Sprintf("The compose file of compose stack %s defines no %s named %q. Defined: %s. Ignore this warning if the compose file is changed to include it in the same apply.",
+			composeID, strings.ToLower(kind), name.ValueString(), composeNamesList(defined)),
+	)
+}
+
+// requireComposeName reports a service or volume that is missing from the
+// names passed in from dokploy_compose. Those reflect the planned compose
+// file, so a missing name is an error.
+func requireComposeName(diags *diag.Diagnostics, attribute, kind string, name types.String, declared []string, declaredAttribute string) {
+	if !composeNameUnknown(name, declared) {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root(attribute),
+		fmt.Sprintf("Unknown Compose %s", kind),
+		fmt.Sprintf("%s contains no %s named %q. Defined: %s.",
+			declaredAttribute, strings.ToLower(kind), name.ValueString(), composeNamesList(declared)),
+	)
+}
+
+func composeNameUnknown(name types.String, defined []string) bool {
+	if name.IsNull() || name.IsUnknown() {
+		return false
+	}
+	for _, candidate := range defined {
+		if candidate == name.ValueString() {
+			return false
+		}
+	}
+	return true
+}
+
+func composeNamesList(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// composeService describes a service of a compose file as Docker deploys it.
+type composeService struct {
+	Name          string
+	Image         string
+	ContainerName string
+	Ports         []string
+	// Volumes are the Docker names of the named volumes the service mounts.
+	Volumes []string
+}
+
+// parseComposeServices reads the services of a compose file deployed under
+// the project name appName. Volume names are qualified the way Docker Compose
+// and Swarm name them: the explicit name if set, the key for external
+// volumes, and <appName>_<key> otherwise.
+func parseComposeServices(content, appName string) ([]composeService, error) {
+	var file struct {
+		Services map[string]struct {
+			Image         string        `yaml:"image"`
+			ContainerName string        `yaml:"container_name"`
+			Ports         []interface{} `yaml:"ports"`
+			Volumes       []interface{} `yaml:"volumes"`
+		} `yaml:"services"`
+		Volumes map[string]*struct {
+			Name     string      `yaml:"name"`
+			External interface{} `yaml:"external"`
+		} `yaml:"volumes"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &file); err != nil {
+		return nil, err
+	}
+
+	qualifiedVolume := func(key string) (string, bool) {
+		volume, ok := file.Volumes[key]
+		switch {
+		case !ok:
+			return "", false
+		case volume != nil && volume.Name != "":
+			return volume.Name, true
+		case volume != nil && volume.External != nil && volume.External != false:
+			if external, ok := volume.External.(map[string]interface{}); ok {
+				if name, ok := external["name"].(string); ok && name != "" {
+					return name, true
+				}
+			}
+			return key, true
+		case appName == "":
+			return key, true
+		}
+		return appName + "_" + key, true
+	}
+
+	services := make([]composeService, 0, len(file.Services))
+	for name, definition := range file.Services {
+		service := composeService{
+			Name:          name,
+			Image:         definition.Image,
+			ContainerName: definition.ContainerName,
+			Ports:         []string{},
+			Volumes:       []string{},
+		}
+		for _, port := range definition.Ports {
+			if value := composePortString(port); value != "" {
+				service.Ports = append(service.Ports, value)
+			}
+		}
+		for _, mount := range definition.Volumes {
+			if volume, ok := qualifiedVolume(composeVolumeSource(mount)); ok {
+				service.Volumes = append(service.Volumes, volume)
+			}
+		}
+		services = append(services, service)
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+	return services, nil
+}
+
+// composePortString renders a short or long syntax port mapping in the short
+// [host_ip:][published:]target[/protocol] form.
+func composePortString(port interface{}) string {
+	mapping, ok := port.(map[string]interface{})
+	if !ok {
+		if port == nil {
+			return ""
+		}
+		return fmt.Sprint(port)
+	}
+
+	value := fmt.Sprint(mapping["target"])
+	if published, ok := mapping["published"]; ok && published != nil {
+		value = fmt.Sprintf("%v:%s", published, value)
+		if hostIP, ok := mapping["host_ip"].(string); ok && hostIP != "" {
+			value = hostIP + ":" + value
+		}
+	}
+	if protocol, ok := mapping["protocol"].(string); ok && protocol != "" {
+		value += "/" + protocol
+	}
+	return value
+}
+
+// composeVolumeSource returns the source of a short or long syntax service
+// volume, or an empty string for anonymous volumes and bind mounts.
+func composeVolumeSource(mount interface{}) string {
+	switch mount := mount.(type) {
+	case string:
+		parts := strings.Split(mount, ":")
+		if len(parts) < 2 {
+			return ""
+		}
+		return parts[0]
+	case map[string]interface{}:
+		if mountType, ok := mount["type"].(string); ok && mountType != "volume" {
+			return ""
+		}
+		source, _ := mount["source"].(string)
+		return source
+	}
+	return ""
+}
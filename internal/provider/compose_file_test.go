@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testComposeFile = `
+services:
+  web:
+    image: nginx
+    volumes:
+      - static:/usr/share/nginx/html
+  db:
+    image: postgres
+    volumes:
+      - db-data:/var/lib/postgresql/data
+volumes:
+  static:
+  db-data:
+    name: shared-db-data
+`
+
+func TestParseComposeFile(t *testing.T) {
+	names, err := parseComposeFile(testComposeFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"db", "web"}; !reflect.DeepEqual(names.Services, want) {
+		t.Fatalf("unexpected services: got %v want %v", names.Services, want)
+	}
+	if want := []string{"db-data", "shared-db-data", "static"}; !reflect.DeepEqual(names.Volumes, want) {
+		t.Fatalf("unexpected volumes: got %v want %v", names.Volumes, want)
+	}
+	if names.Partial {
+		t.Fatal("expected a complete compose file")
+	}
+}
+
+func TestParseComposeFile_IncludeIsPartial(t *testing.T) {
+	names, err := parseComposeFile("include:\n  - other.yml\nservices:\n  web:\n    image: nginx\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !names.Partial {
+		t.Fatal("expected include to mark the compose file as partial")
+	}
+}
+
+func TestParseComposeFile_ScalarVolumeDefinitions(t *testing.T) {
+	names, err := parseComposeFile("services:\n  web:\n    image: nginx\nvolumes:\n  data: \"\"\n  cache:\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"cache", "data"}; !reflect.DeepEqual(names.Volumes, want) {
+		t.Fatalf("unexpected volumes: got %v want %v", names.Volumes, want)
+	}
+}
+
+func TestParseComposeFile_InvalidYAML(t *testing.T) {
+	if _, err := parseComposeFile("services:\n  web: [\n"); err == nil {
+		t.Fatal("expected an error for invalid YAML")
+	}
+	if _, err := parseComposeFile("services:\n  - web\n"); err == nil {
+		t.Fatal("expected an error for a services list")
+	}
+}
+
+func TestComposeFileState(t *testing.T) {
+	ctx := context.Background()
+
+	services, volumes, diags := composeFileState(ctx, types.StringValue(testComposeFile))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var serviceNames []string
+	services.ElementsAs(ctx, &serviceNames, false)
+	if want := []string{"db", "web"}; !reflect.DeepEqual(serviceNames, want) {
+		t.Fatalf("unexpected services: got %v want %v", serviceNames, want)
+	}
+	if len(volumes.Elements()) != 3 {
+		t.Fatalf("unexpected volumes: %v", volumes)
+	}
+
+	services, volumes, _ = composeFileState(ctx, types.StringUnknown())
+	if !services.IsUnknown() || !volumes.IsUnknown() {
+		t.Fatal("expected unknown lists for unknown content")
+	}
+	for _, content := range []types.String{types.StringNull(), types.StringValue("  \n")} {
+		services, volumes, diags = composeFileState(ctx, content)
+		if !services.IsNull() || !volumes.IsNull() || diags.HasError() {
+			t.Fatalf("expected null lists without diagnostics for %v", content)
+		}
+	}
+
+	services, volumes, diags = composeFileState(ctx, types.StringValue("services: ["))
+	if !services.IsNull() || !volumes.IsNull() {
+		t.Fatal("expected null lists for invalid content")
+	}
+	if diags.HasError() || len(diags) != 1 || diags[0].Summary() != "Unreadable Compose File" {
+		t.Fatalf("expected an unreadable compose file warning, got %v", diags)
+	}
+}
+
+func TestWarnUnknownComposeName(t *testing.T) {
+	var diags diag.Diagnostics
+	warnUnknownComposeName(&diags, "service_name", "Service", types.StringValue("web"), []string{"db", "web"}, "c1")
+	warnUnknownComposeName(&diags, "service_name", "Service", types.StringNull(), []string{"db"}, "c1")
+	if len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	warnUnknownComposeName(&diags, "volume_name", "Volume", types.StringValue("dbdata"), []string{"db-data"}, "c1")
+	if len(diags) != 1 || diags.HasError() {
+		t.Fatalf("expected one warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), `"dbdata"`) || !strings.Contains(diags[0].Detail(), "db-data") {
+		t.Fatalf("unexpected detail: %s", diags[0].Detail())
+	}
+}
+
+func TestRequireComposeName(t *testing.T) {
+	var diags diag.Diagnostics
+	requireComposeName(&diags, "service_name", "Service", types.StringValue("web"), []string{"db", "web"}, "compose_services")
+	requireComposeName(&diags, "service_name", "Service", types.StringUnknown(), []string{"db"}, "compose_services")
+	if len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	requireComposeName(&diags, "service_name", "Service", types.StringValue("api"), []string{"db", "web"}, "compose_services")
+	if !diags.HasError() {
+		t.Fatalf("expected an error, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), `"api"`) || !strings.Contains(diags[0].Detail(), "db, web") {
+		t.Fatalf("unexpected detail: %s", diags[0].Detail())
+	}
+}
+
+func TestDeclaredComposeNames(t *testing.T) {
+	ctx := context.Background()
+	for _, list := range []types.List{types.ListNull(types.StringType), types.ListUnknown(types.StringType)} {
+		if _, ok, _ := declaredComposeNames(ctx, list); ok {
+			t.Fatalf("expected %v to declare nothing", list)
+		}
+	}
+
+	names, ok, diags := declaredComposeNames(ctx, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("web")}))
+	if !ok || diags.HasError() || !reflect.DeepEqual(names, []string{"web"}) {
+		t.Fatalf("unexpected result: %v %v %v", names, ok, diags)
+	}
+}
+
+func TestParseComposeServices(t *testing.T) {
+	content := `
+services:
+  web:
+    image: nginx:1.27
+    ports:
+      - "8080:80"
+      - 443
+      - target: 53
+        published: 5353
+        host_ip: 127.0.0.1
+        protocol: udp
+    volumes:
+      - static:/usr/share/nginx/html:ro
+      - ./conf:/etc/nginx/conf.d
+      - /var/cache/nginx
+  db:
+    image: postgres
+    container_name: shop-db
+    volumes:
+      - type: volume
+        source: db-data
+        target: /var/lib/postgresql/data
+      - type: volume
+        source: backups
+        target: /backups
+      - type: bind
+        source: ./init
+        target: /docker-entrypoint-initdb.d
+volumes:
+  static:
+  db-data:
+    name: shared-db-data
+  backups:
+    external: true
+`
+	services, err := parseComposeServices(content, "shop-abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(services) != 2 || services[0].Name != "db" || services[1].Name != "web" {
+		t.Fatalf("unexpected services: %+v", services)
+	}
+
+	db, web := services[0], services[1]
+	if db.ContainerName != "shop-db" || db.Image != "postgres" {
+		t.Fatalf("unexpected db service: %+v", db)
+	}
+	if want := []string{"shared-db-data", "backups"}; !reflect.DeepEqual(db.Volumes, want) {
+		t.Fatalf("unexpected db volumes: got %v want %v", db.Volumes, want)
+	}
+	if want := []string{"8080:80", "443", "127.0.0.1:5353:53/udp"}; !reflect.DeepEqual(web.Ports, want) {
+		t.Fatalf("unexpected web ports: got %v want %v", web.Ports, want)
+	}
+	if want := []string{"shop-abc123_static"}; !reflect.DeepEqual(web.Volumes, want) {
+		t.Fatalf("unexpected web volumes: got %v want %v", web.Volumes, want)
+	}
+}
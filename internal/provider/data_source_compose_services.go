@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &ComposeServicesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ComposeServicesDataSource{}
+
+func NewComposeServicesDataSource() datasource.DataSource {
+	return &ComposeServicesDataSource{}
+}
+
+type ComposeServicesDataSource struct {
+	client *client.DokployClient
+}
+
+type ComposeServicesDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ComposeID   types.String `tfsdk:"compose_id"`
+	AppName     types.String `tfsdk:"app_name"`
+	ComposeType types.String `tfsdk:"compose_type"`
+	Services    types.List   `tfsdk:"services"`
+}
+
+var composeServiceAttrTypes = map[string]attr.Type{
+	"name":            types.StringType,
+	"qualified_name":  types.StringType,
+	"image":           types.StringType,
+	"ports":           types.ListType{ElemType: types.StringType},
+	"volumes":         types.ListType{ElemType: types.StringType},
+	"container_names": types.ListType{ElemType: types.StringType},
+}
+
+var composeServiceObjectType = types.ObjectType{AttrTypes: composeServiceAttrTypes}
+
+func (d *ComposeServicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_services"
+}
+
+func (d *ComposeServicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the services of a Dokploy compose stack with the names, ports and volumes Docker gives them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"compose_id": schema.StringAttribute{
+				Required: true,
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Project name the stack is deployed under. Prefixes container, service and volume names.",
+			},
+			"compose_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "docker-compose or stack.",
+			},
+			"services": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Services sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service name in the compose file.",
+						},
+						"qualified_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name Docker gives the service: <app_name>_<name> for stacks, and container_name or <app_name>-<name>-1 for docker-compose.",
+						},
+						"image": schema.StringAttribute{
+							Computed: true,
+						},
+						"ports": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Port mappings in [host_ip:][published:]target[/protocol] form.",
+						},
+						"volumes": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Docker names of the named volumes the service mounts. Bind mounts and anonymous volumes are omitted.",
+						},
+						"container_names": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Names of the service's containers, running or stopped. Empty when Dokploy cannot list containers.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ComposeServicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ComposeServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ComposeServicesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	composeID := config.ComposeID.ValueString()
+	comp, err := d.client.GetCompose(composeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading compose stack", err.Error())
+		return
+	}
+	composeType := comp.ComposeType
+	if composeType == "" {
+		composeType = "docker-compose"
+	}
+
+	// The converted file carries the randomized names Dokploy deploys with;
+	// it is unavailable until a git source has been fetched.
+	content, err := d.client.GetConvertedCompose(composeID)
+	if err != nil {
+		content = comp.ComposeFile
+	}
+	parsed, parseErr := parseComposeServices(content, comp.AppName)
+
+	names, err := d.client.LoadComposeServices(composeID)
+	if err != nil {
+		if parseErr != nil || strings.TrimSpace(content) == "" {
+			resp.Diagnostics.AddError("Error loading compose services", err.Error())
+			return
+		}
+		names = nil
+	}
+
+	containers, err := d.client.ListContainersByAppName(comp.AppName, composeType, comp.ServerID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Containers Unavailable",
+			fmt.Sprintf("Could not list the containers of compose stack %s, container_names is empty: %s", composeID, err.Error()))
+		containers = nil
+	}
+
+	items, diags := composeServicesState(ctx, comp.AppName, composeType, names, parsed, containers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(composeID)
+	config.AppName = types.StringValue(comp.AppName)
+	config.ComposeType = types.StringValue(composeType)
+	config.Services, diags = types.ListValue(composeServiceObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// composeServicesState builds the services list. names, as reported by
+// Dokploy, decides which services are listed; parsed services fill in the
+// details. Without names every parsed service is listed.
+func composeServicesState(ctx context.Context, appName, composeType string, names []string, parsed []composeService, containers []client.Container) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	byName := make(map[string]composeService, len(parsed))
+	for _, service := range parsed {
+		byName[service.Name] = service
+	}
+	if names == nil {
+		for _, service := range parsed {
+			names = append(names, service.Name)
+		}
+	}
+	names = append([]string(nil), names...)
+	sort.Strings(names)
+
+	items := make([]attr.Value, 0, len(names))
+	for _, name := range names {
+		service, ok := byName[name]
+		if !ok {
+			service = composeService{Name: name}
+		}
+
+		qualifiedName := appName + "-" + name + "-1"
+		if composeType == "stack" {
+			qualifiedName = appName + "_" + name
+		} else if service.ContainerName != "" {
+			qualifiedName = service.ContainerName
+		}
+
+		containerNames := []string{}
+		for _, container := range containers {
+			if composeContainerBelongsTo(container.Name, appName, composeType, service) {
+				containerNames = append(containerNames, container.Name)
+			}
+		}
+		sort.Strings(containerNames)
+
+		ports, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(service.Ports))
+		diags.Append(d...)
+		volumes, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(service.Volumes))
+		diags.Append(d...)
+		containerList, d := types.ListValueFrom(ctx, types.StringType, containerNames)
+		diags.Append(d...)
+
+		image := types.StringNull()
+		if service.Image != "" {
+			image = types.StringValue(service.Image)
+		}
+		items = append(items, types.ObjectValueMust(composeServiceAttrTypes, map[string]attr.Value{
+			"name":            types.StringValue(name),
+			"qualified_name":  types.StringValue(qualifiedName),
+			"image":           image,
+			"ports":           ports,
+			"volumes":         volumes,
+			"container_names": containerList,
+		}))
+	}
+	return items, diags
+}
+
+// composeContainerBelongsTo reports whether a container was created for the
+// service. Docker Compose names containers <project>-<service>-<n> unless
+// container_name is set; Swarm names tasks <stack>_<service>.<n>.<id>.
+func composeContainerBelongsTo(containerName, appName, composeType string, service composeService) bool {
+	containerName = strings.TrimPrefix(containerName, "/")
+	if composeType == "stack" {
+		return strings.HasPrefix(containerName, appName+"_"+service.Name+".")
+	}
+	if service.ContainerName != "" {
+		return containerName == service.ContainerName
+	}
+	replica, ok := strings.CutPrefix(containerName, appName+"-"+service.Name+"-")
+	if !ok {
+		return false
+	}
+	_, err := strconv.Atoi(replica)
+	return err == nil
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
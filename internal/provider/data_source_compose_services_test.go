@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestComposeContainerBelongsTo(t *testing.T) {
+	web := composeService{Name: "web"}
+	tests := []struct {
+		name        string
+		container   string
+		composeType string
+		service     composeService
+		expected    bool
+	}{
+		{name: "compose replica", container: "shop-web-1", composeType: "docker-compose", service: web, expected: true},
+		{name: "compose other service", container: "shop-web-admin-1", composeType: "docker-compose", service: web, expected: false},
+		{name: "compose container_name", container: "/shop-db", composeType: "docker-compose", service: composeService{Name: "db", ContainerName: "shop-db"}, expected: true},
+		{name: "stack task", container: "shop_web.2.k3j4", composeType: "stack", service: web, expected: true},
+		{name: "stack other service", container: "shop_web-admin.1.k3j4", composeType: "stack", service: web, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := composeContainerBelongsTo(test.container, "shop", test.composeType, test.service); got != test.expected {
+				t.Fatalf("unexpected value: got %v want %v", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestComposeServicesState(t *testing.T) {
+	ctx := context.Background()
+	parsed := []composeService{
+		{Name: "web", Image: "nginx", Ports: []string{"8080:80"}, Volumes: []string{"shop_static"}},
+		{Name: "worker", Image: "worker"},
+	}
+	containers := []client.Container{{Name: "shop-web-1"}, {Name: "shop-web-2"}, {Name: "shop-cron-1"}}
+
+	items, diags := composeServicesState(ctx, "shop", "docker-compose", []string{"web", "cron"}, parsed, containers)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected the services reported by Dokploy, got %d", len(items))
+	}
+
+	cron := items[0].(types.Object).Attributes()
+	if cron["name"].(types.String).ValueString() != "cron" || !cron["image"].IsNull() {
+		t.Fatalf("unexpected cron service: %v", cron)
+	}
+	web := items[1].(types.Object).Attributes()
+	if web["qualified_name"].(types.String).ValueString() != "shop-web-1" {
+		t.Fatalf("unexpected qualified_name: %v", web["qualified_name"])
+	}
+	if len(web["container_names"].(types.List).Elements()) != 2 || len(web["volumes"].(types.List).Elements()) != 1 {
+		t.Fatalf("unexpected web service: %v", web)
+	}
+
+	items, _ = composeServicesState(ctx, "shop", "stack", nil, parsed, nil)
+	if len(items) != 2 {
+		t.Fatalf("expected every parsed service without names, got %d", len(items))
+	}
+	if got := items[0].(types.Object).Attributes()["qualified_name"].(types.String).ValueString(); got != "shop_web" {
+		t.Fatalf("unexpected stack qualified_name: %s", got)
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &ComposeTemplatesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ComposeTemplatesDataSource{}
+
+func NewComposeTemplatesDataSource() datasource.DataSource {
+	return &ComposeTemplatesDataSource{}
+}
+
+type ComposeTemplatesDataSource struct {
+	client *client.DokployClient
+}
+
+type ComposeTemplatesDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	BaseURL   types.String `tfsdk:"base_url"`
+	Tag       types.String `tfsdk:"tag"`
+	Templates types.List   `tfsdk:"templates"`
+}
+
+var composeTemplateAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"description": types.StringType,
+	"version":     types.StringType,
+	"logo":        types.StringType,
+	"tags":        types.ListType{ElemType: types.StringType},
+	"github":      types.StringType,
+	"website":     types.StringType,
+	"docs":        types.StringType,
+}
+
+var composeTemplateObjectType = types.ObjectType{AttrTypes: composeTemplateAttrTypes}
+
+func (d *ComposeTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_templates"
+}
+
+func (d *ComposeTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the one-click compose templates offered by Dokploy, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"base_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an alternative template catalogue. Defaults to Dokploy's catalogue.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list templates with this tag, e.g. analytics.",
+			},
+			"templates": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Template ID, as used by template_id on dokploy_compose_template.",
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Computed: true,
+						},
+						"logo": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"github": schema.StringAttribute{
+							Computed: true,
+						},
+						"website": schema.StringAttribute{
+							Computed: true,
+						},
+						"docs": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ComposeTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ComposeTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ComposeTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := d.client.ListComposeTemplates(config.BaseURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing compose templates", err.Error())
+		return
+	}
+	templates = filterComposeTemplates(templates, config.Tag.ValueString())
+
+	items := make([]attr.Value, 0, len(templates))
+	for _, template := range templates {
+		tags, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(template.Tags))
+		resp.Diagnostics.Append(diags...)
+		items = append(items, types.ObjectValueMust(composeTemplateAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(template.ID),
+			"name":        types.StringValue(template.Name),
+			"description": types.StringValue(template.Description),
+			"version":     types.StringValue(template.Version),
+			"logo":        types.StringValue(template.Logo),
+			"tags":        tags,
+			"github":      types.StringValue(template.Links.Github),
+			"website":     types.StringValue(template.Links.Website),
+			"docs":        types.StringValue(template.Links.Docs),
+		}))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue("compose_templates")
+	if tag := config.Tag.ValueString(); tag != "" {
+		config.ID = types.StringValue(tag)
+	}
+	config.Templates, diags = types.ListValue(composeTemplateObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterComposeTemplates returns the templates tagged tag, sorted by ID. An
+// empty tag matches everything.
+func filterComposeTemplates(templates []client.ComposeTemplate, tag string) []client.ComposeTemplate {
+	filtered := make([]client.ComposeTemplate, 0, len(templates))
+	for _, template := range templates {
+		if tag != "" && !slices.Contains(template.Tags, tag) {
+			continue
+		}
+		filtered = append(filtered, template)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool { return filtered[i].ID < filtered[j].ID })
+	return filtered
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestFilterComposeTemplates(t *testing.T) {
+	templates := []client.ComposeTemplate{
+		{ID: "umami", Tags: []string{"analytics"}},
+		{ID: "n8n", Tags: []string{"automation"}},
+		{ID: "plausible", Tags: []string{"analytics", "privacy"}},
+	}
+
+	filtered := filterComposeTemplates(templates, "analytics")
+	if len(filtered) != 2 || filtered[0].ID != "plausible" || filtered[1].ID != "umami" {
+		t.Fatalf("unexpected templates: %+v", filtered)
+	}
+	if all := filterComposeTemplates(templates, ""); len(all) != 3 || all[0].ID != "n8n" {
+		t.Fatalf("unexpected templates: %+v", all)
+	}
+}
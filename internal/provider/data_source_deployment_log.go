@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &DeploymentLogDataSource{}
+var _ datasource.DataSourceWithConfigure = &DeploymentLogDataSource{}
+
+func NewDeploymentLogDataSource() datasource.DataSource {
+	return &DeploymentLogDataSource{}
+}
+
+type DeploymentLogDataSource struct {
+	client *client.DokployClient
+}
+
+type DeploymentLogDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	ComposeID     types.String `tfsdk:"compose_id"`
+	DeploymentID  types.String `tfsdk:"deployment_id"`
+	TailLines     types.Int64  `tfsdk:"tail_lines"`
+	Status        types.String `tfsdk:"status"`
+	Title         types.String `tfsdk:"title"`
+	ErrorMessage  types.String `tfsdk:"error_message"`
+	Log           types.String `tfsdk:"log"`
+}
+
+func (d *DeploymentLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_log"
+}
+
+func (d *DeploymentLogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the build log of a deployment of a Dokploy application or compose stack.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"application_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"compose_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"deployment_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Deployment to read. Defaults to the latest deployment.",
+			},
+			"tail_lines": schema.Int64Attribute{
+				Optional:    true,
+				Description: "If set, only the last N lines of the log are returned.",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"title": schema.StringAttribute{
+				Computed: true,
+			},
+			"error_message": schema.StringAttribute{
+				Computed: true,
+			},
+			"log": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *DeploymentLogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *DeploymentLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentLogDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TailLines.IsNull() && config.TailLines.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("tail_lines"), "Invalid Tail Lines", "tail_lines must be at least 1.")
+		return
+	}
+
+	deployments, _, err := listTargetDeployments(d.client, config.ApplicationID, config.ComposeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing deployments", err.Error())
+		return
+	}
+
+	var deployment *client.Deployment
+	wantedID := strings.TrimSpace(config.DeploymentID.ValueString())
+	for i := range deployments {
+		if wantedID == "" || deployments[i].ID == wantedID {
+			deployment = &deployments[i]
+			break
+		}
+	}
+	if deployment == nil {
+		if wantedID == "" {
+			resp.Diagnostics.AddError("No Deployments Found", "The target has not been deployed yet.")
+		} else {
+			resp.Diagnostics.AddAttributeError(path.Root("deployment_id"), "Deployment Not Found", fmt.Sprintf("Deployment %q was not found for the target.", wantedID))
+		}
+		return
+	}
+
+	log, err := d.client.ReadDeploymentLog(ctx, *deployment)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading deployment log", err.Error())
+		return
+	}
+	if !config.TailLines.IsNull() {
+		log = client.TailLines(log, int(config.TailLines.ValueInt64()))
+	}
+
+	config.ID = types.StringValue(deployment.ID)
+	config.DeploymentID = types.StringValue(deployment.ID)
+	config.Status = types.StringValue(deployment.Status)
+	config.Title = types.StringValue(deployment.Title)
+	config.ErrorMessage = types.StringValue(deployment.ErrorMessage)
+	config.Log = types.StringValue(log)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
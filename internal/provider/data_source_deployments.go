@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+var _ datasource.DataSourceWithConfigure = &DeploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type DeploymentsDataSource struct {
+	client *client.DokployClient
+}
+
+type DeploymentsDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	ComposeID     types.String `tfsdk:"compose_id"`
+	Deployments   types.List   `tfsdk:"deployments"`
+}
+
+var deploymentAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"status":      types.StringType,
+	"title":       types.StringType,
+	"description": types.StringType,
+	"commit_hash": types.StringType,
+	"created_at":  types.StringType,
+	"started_at":  types.StringType,
+	"finished_at": types.StringType,
+}
+
+var deploymentObjectType = types.ObjectType{AttrTypes: deploymentAttrTypes}
+
+func (d *DeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the deployment history of a Dokploy application or compose stack, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"application_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"compose_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Deployment status: running, done, error or cancelled.",
+						},
+						"title": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"commit_hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Commit that was deployed, when reported by Dokploy.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"started_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"finished_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments, targetID, err := listTargetDeployments(d.client, config.ApplicationID, config.ComposeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing deployments", err.Error())
+		return
+	}
+
+	items := make([]attr.Value, 0, len(deployments))
+	for _, deployment := range deployments {
+		items = append(items, types.ObjectValueMust(deploymentAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(deployment.ID),
+			"status":      types.StringValue(deployment.Status),
+			"title":       types.StringValue(deployment.Title),
+			"description": types.StringValue(deployment.Description),
+			"commit_hash": types.StringValue(deployment.CommitHash),
+			"created_at":  types.StringValue(deployment.CreatedAt),
+			"started_at":  types.StringValue(deployment.StartedAt),
+			"finished_at": types.StringValue(deployment.FinishedAt),
+		}))
+	}
+
+	config.ID = types.StringValue(targetID)
+	config.Deployments, diags = types.ListValue(deploymentObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listTargetDeployments lists the deployments of the configured application or
+// compose stack, newest first.
+func listTargetDeployments(c *client.DokployClient, applicationID, composeID types.String) ([]client.Deployment, string, error) {
+	targetType, targetID, err := getEnvironmentVariableTarget(applicationID, composeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var deployments []client.Deployment
+	if targetType == "application" {
+		deployments, err = c.ListApplicationDeployments(targetID)
+	} else {
+		deployments, err = c.ListComposeDeployments(targetID)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].CreatedAt > deployments[j].CreatedAt
+	})
+	return deployments, targetID, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &GitProviderDataSource{}
+var _ datasource.DataSourceWithConfigure = &GitProviderDataSource{}
+
+func NewGitProviderDataSource() datasource.DataSource {
+	return &GitProviderDataSource{}
+}
+
+type GitProviderDataSource struct {
+	client *client.DokployClient
+}
+
+type GitProviderDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	GitProviderID types.String `tfsdk:"git_provider_id"`
+	Name          types.String `tfsdk:"name"`
+	ProviderType  types.String `tfsdk:"provider_type"`
+	URL           types.String `tfsdk:"url"`
+	AppName       types.String `tfsdk:"app_name"`
+	GroupName     types.String `tfsdk:"group_name"`
+	WorkspaceName types.String `tfsdk:"workspace_name"`
+}
+
+func (d *GitProviderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_provider"
+}
+
+func (d *GitProviderDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a GitHub, GitLab, Bitbucket or Gitea integration by name, e.g. to set github_id on an application.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Integration ID, as used by github_id, gitlab_id, bitbucket_id or gitea_id.",
+			},
+			"git_provider_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"provider_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Integration type: github, gitlab, bitbucket or gitea. Set it when integrations of different types share a name.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base URL of a GitLab or Gitea instance.",
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the GitHub App.",
+			},
+			"group_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "GitLab group the integration is limited to.",
+			},
+			"workspace_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Bitbucket workspace of the integration.",
+			},
+		},
+	}
+}
+
+func (d *GitProviderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *GitProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GitProviderDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := d.client.ListGitProviders()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing git providers", err.Error())
+		return
+	}
+	matches := filterGitProviders(providers, config.ProviderType.ValueString(), config.Name.ValueString())
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("Git Provider Not Found", fmt.Sprintf("No git provider named %q was found.", config.Name.ValueString()))
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Git Provider",
+			fmt.Sprintf("%d git providers are named %q. Set provider_type or rename them in Dokploy.", len(matches), config.Name.ValueString()),
+		)
+		return
+	}
+	provider := matches[0]
+	integrationID := provider.IntegrationID()
+	if integrationID == "" {
+		resp.Diagnostics.AddError("Error reading git provider", fmt.Sprintf("Dokploy returned no %s ID for git provider %s", provider.ProviderType, provider.ID))
+		return
+	}
+
+	config.ID = types.StringValue(integrationID)
+	config.GitProviderID = types.StringValue(provider.ID)
+	config.ProviderType = types.StringValue(provider.ProviderType)
+	config.URL = types.StringNull()
+	config.AppName = types.StringNull()
+	config.GroupName = types.StringNull()
+	config.WorkspaceName = types.StringNull()
+
+	switch provider.ProviderType {
+	case "github":
+		github, err := d.client.GetGithubProvider(integrationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading GitHub provider", err.Error())
+			return
+		}
+		config.AppName = optionalStringState(types.StringNull(), github.AppName)
+	case "gitlab":
+		gitlab, err := d.client.GetGitlabProvider(integrationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading GitLab provider", err.Error())
+			return
+		}
+		config.URL = optionalStringState(types.StringNull(), gitlab.GitlabURL)
+		config.GroupName = optionalStringState(types.StringNull(), gitlab.GroupName)
+	case "bitbucket":
+		bitbucket, err := d.client.GetBitbucketProvider(integrationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Bitbucket provider", err.Error())
+			return
+		}
+		config.WorkspaceName = optionalStringState(types.StringNull(), bitbucket.WorkspaceName)
+	case "gitea":
+		gitea, err := d.client.GetGiteaProvider(integrationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Gitea provider", err.Error())
+			return
+		}
+		config.URL = optionalStringState(types.StringNull(), gitea.GiteaURL)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
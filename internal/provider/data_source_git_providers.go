@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ datasource.DataSource = &GitProvidersDataSource{}
+var _ datasource.DataSourceWithConfigure = &GitProvidersDataSource{}
+
+func NewGitProvidersDataSource() datasource.DataSource {
+	return &GitProvidersDataSource{}
+}
+
+type GitProvidersDataSource struct {
+	client *client.DokployClient
+}
+
+type GitProvidersDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProviderType types.String `tfsdk:"provider_type"`
+	GitProviders types.List   `tfsdk:"git_providers"`
+}
+
+var gitProviderAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"git_provider_id": types.StringType,
+	"name":            types.StringType,
+	"provider_type":   types.StringType,
+	"created_at":      types.StringType,
+}
+
+var gitProviderObjectType = types.ObjectType{AttrTypes: gitProviderAttrTypes}
+
+func (d *GitProvidersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_git_providers"
+}
+
+func (d *GitProvidersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the GitHub, GitLab, Bitbucket and Gitea integrations configured in Dokploy, sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"provider_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list integrations of this type: github, gitlab, bitbucket or gitea.",
+			},
+			"git_providers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Integration ID, as used by github_id, gitlab_id, bitbucket_id or gitea_id.",
+						},
+						"git_provider_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"provider_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GitProvidersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *GitProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GitProvidersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := d.client.ListGitProviders()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing git providers", err.Error())
+		return
+	}
+	providers = filterGitProviders(providers, config.ProviderType.ValueString(), "")
+
+	items := make([]attr.Value, 0, len(providers))
+	for _, provider := range providers {
+		items = append(items, types.ObjectValueMust(gitProviderAttrTypes, map[string]attr.Value{
+			"id":              types.StringValue(provider.IntegrationID()),
+			"git_provider_id": types.StringValue(provider.ID),
+			"name":            types.StringValue(provider.Name),
+			"provider_type":   types.StringValue(provider.ProviderType),
+			"created_at":      types.StringValue(provider.CreatedAt),
+		}))
+	}
+
+	config.ID = types.StringValue("git_providers")
+	if providerType := config.ProviderType.ValueString(); providerType != "" {
+		config.ID = types.StringValue(providerType)
+	}
+	config.GitProviders, diags = types.ListValue(gitProviderObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterGitProviders returns the integrations matching providerType and name,
+// sorted by name. Empty filters match everything.
+func filterGitProviders(providers []client.GitProvider, providerType, name string) []client.GitProvider {
+	filtered := make([]client.GitProvider, 0, len(providers))
+	for _, provider := range providers {
+		if providerType != "" && provider.ProviderType != providerType {
+			continue
+		}
+		if name != "" && provider.Name != name {
+			continue
+		}
+		filtered = append(filtered, provider)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Name != filtered[j].Name {
+			return filtered[i].Name < filtered[j].Name
+		}
+		return filtered[i].CreatedAt < filtered[j].CreatedAt
+	})
+	return filtered
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestFilterGitProviders(t *testing.T) {
+	providers := []client.GitProvider{
+		{ID: "gp-3", Name: "work", ProviderType: "gitea"},
+		{ID: "gp-1", Name: "personal", ProviderType: "github"},
+		{ID: "gp-2", Name: "work", ProviderType: "gitlab"},
+	}
+
+	all := filterGitProviders(providers, "", "")
+	if len(all) != 3 || all[0].ID != "gp-1" {
+		t.Fatalf("expected all providers sorted by name, got %#v", all)
+	}
+
+	named := filterGitProviders(providers, "", "work")
+	if len(named) != 2 {
+		t.Fatalf("expected two providers named work, got %#v", named)
+	}
+
+	typed := filterGitProviders(providers, "gitlab", "work")
+	if len(typed) != 1 || typed[0].ID != "gp-2" {
+		t.Fatalf("expected the gitlab provider, got %#v", typed)
+	}
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+const (
+	// defaultDeploymentTimeout applies when no timeouts block is configured.
+	defaultDeploymentTimeout = 20 * time.Minute
+	// deploymentLogTailLines is how much of a failed build log ends up in the diagnostic.
+	deploymentLogTailLines = 40
+)
+
+// actionTimeout parses the optional timeout attribute of deploy actions.
+func actionTimeout(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() || strings.TrimSpace(value.ValueString()) == "" {
+		return defaultDeploymentTimeout, nil
+	}
+	timeout, err := time.ParseDuration(strings.TrimSpace(value.ValueString()))
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q: %w", value.ValueString(), err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be positive, got %q", value.ValueString())
+	}
+	return timeout, nil
+}
+
+// redeployTriggersChanged reports whether redeploy_triggers changed in a way
+// that should redeploy. Removing the triggers altogether does not redeploy.
+func redeployTriggersChanged(plan, state types.Map) bool {
+	if plan.IsNull() || plan.IsUnknown() {
+		return false
+	}
+	if state.IsNull() || state.IsUnknown() {
+		return len(plan.Elements()) > 0
+	}
+	return !plan.Equal(state)
+}
+
+// redeployTarget deploys an application or compose stack during an update and
+// optionally waits for the result. It reports whether the deployment succeeded
+// as far as it was observed.
+func redeployTarget(ctx context.Context, c *client.DokployClient, targetType, targetID string, wait bool, timeout time.Duration, diags *diag.Diagnostics) bool {
+	previousID := ""
+	if wait {
+		if targetType == "compose" {
+			previousID = c.LatestComposeDeploymentID(targetID)
+		} else {
+			previousID = c.LatestApplicationDeploymentID(targetID)
+		}
+	}
+
+	var err error
+	if targetType == "compose" {
+		err = c.DeployCompose(targetID)
+	} else {
+		err = c.DeployApplication(targetID)
+	}
+	if err != nil {
+		diags.AddError("Redeploy Failed", fmt.Sprintf("The %s was updated but the redeploy failed to trigger: %s", targetType, err.Error()))
+		return false
+	}
+
+	if !wait {
+		return true
+	}
+	var waitDiags diag.Diagnostics
+	waitForDeployment(ctx, c, targetType, targetID, previousID, timeout, &waitDiags)
+	diags.Append(waitDiags...)
+	return !waitDiags.HasError()
+}
+
+// waitForDeployment blocks until the deployment started after previousID
+// finishes and reports a failed or timed out deployment as an error diagnostic.
+func waitForDeployment(ctx context.Context, c *client.DokployClient, targetType, targetID, previousID string, timeout time.Duration, diags *diag.Diagnostics) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var err error
+	if targetType == "compose" {
+		_, err = c.WaitForComposeDeployment(waitCtx, targetID, previousID)
+	} else {
+		_, err = c.WaitForApplicationDeployment(waitCtx, targetID, previousID)
+	}
+	if err == nil {
+		return
+	}
+
+	var deploymentErr *client.DeploymentError
+	if !errors.As(err, &deploymentErr) {
+		diags.AddError("Deployment Wait Failed", fmt.Sprintf("Waiting for the %s deployment failed: %s", targetType, err.Error()))
+		return
+	}
+
+	diags.AddError("Deployment Failed", deploymentFailureDetail(ctx, c, deploymentErr.Deployment))
+}
+
+func deploymentFailureDetail(ctx context.Context, c *client.DokployClient, deployment client.Deployment) string {
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "Deployment %q (%s) finished with status %q.", deployment.Title, deployment.ID, deployment.Status)
+	if msg := strings.TrimSpace(deployment.ErrorMessage); msg != "" {
+		fmt.Fprintf(&detail, "\n\nError: %s", msg)
+	}
+
+	// The build log is only a diagnostic aid; failing to fetch it must not hide the failure itself.
+	log, err := c.ReadDeploymentLog(ctx, deployment)
+	switch {
+	case err != nil:
+		fmt.Fprintf(&detail, "\n\nThe build log could not be read: %s", err.Error())
+	case strings.TrimSpace(log) != "":
+		fmt.Fprintf(&detail, "\n\nLast lines of the build log:\n%s", client.TailLines(log, deploymentLogTailLines))
+	}
+	return detail.String()
+}
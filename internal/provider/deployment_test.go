@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRedeployTriggersChanged(t *testing.T) {
+	triggers := func(values map[string]string) types.Map {
+		elements := map[string]attr.Value{}
+		for k, v := range values {
+			elements[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+	nullMap := types.MapNull(types.StringType)
+
+	tests := []struct {
+		name     string
+		plan     types.Map
+		state    types.Map
+		expected bool
+	}{
+		{name: "unchanged", plan: triggers(map[string]string{"env": "a"}), state: triggers(map[string]string{"env": "a"}), expected: false},
+		{name: "value changed", plan: triggers(map[string]string{"env": "b"}), state: triggers(map[string]string{"env": "a"}), expected: true},
+		{name: "key added", plan: triggers(map[string]string{"env": "a", "domain": "d"}), state: triggers(map[string]string{"env": "a"}), expected: true},
+		{name: "first set", plan: triggers(map[string]string{"env": "a"}), state: nullMap, expected: true},
+		{name: "empty first set", plan: triggers(map[string]string{}), state: nullMap, expected: false},
+		{name: "removed", plan: nullMap, state: triggers(map[string]string{"env": "a"}), expected: false},
+		{name: "both null", plan: nullMap, state: nullMap, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := redeployTriggersChanged(test.plan, test.state); got != test.expected {
+				t.Fatalf("unexpected result: got %t want %t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestActionTimeout(t *testing.T) {
+	if got, err := actionTimeout(types.StringNull()); err != nil || got != defaultDeploymentTimeout {
+		t.Fatalf("expected default timeout, got %s (%v)", got, err)
+	}
+	if got, err := actionTimeout(types.StringValue("45m")); err != nil || got != 45*time.Minute {
+		t.Fatalf("expected 45m, got %s (%v)", got, err)
+	}
+	if _, err := actionTimeout(types.StringValue("soon")); err == nil {
+		t.Fatalf("expected error for invalid duration")
+	}
+	if _, err := actionTimeout(types.StringValue("0s")); err == nil {
+		t.Fatalf("expected error for non-positive duration")
+	}
+}
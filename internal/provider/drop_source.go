@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dropArchiveModTime is stamped on every zip entry so the archive, and with
+// it source_hash, only changes when file names, modes or contents change.
+var dropArchiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// readDropSource returns the archive to upload for a drop application and its
+// SHA-256 hash. Exactly one of directory and archive is expected to be set.
+func readDropSource(directory, archive types.String) ([]byte, string, error) {
+	var content []byte
+	var err error
+	switch {
+	case !directory.IsNull():
+		content, err = zipDirectory(directory.ValueString())
+	case !archive.IsNull():
+		content, err = os.ReadFile(archive.ValueString())
+	default:
+		return nil, "", fmt.Errorf("either source_directory or source_archive must be set for drop applications")
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(content)
+	return content, hex.EncodeToString(sum[:]), nil
+}
+
+// zipDirectory zips the regular files below root in lexical order with fixed
+// timestamps and normalized permissions, so identical trees produce identical
+// archives.
+func zipDirectory(root string) ([]byte, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source_directory %q is not a directory", root)
+	}
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !fileInfo.Mode().IsRegular() {
+			return nil
+		}
+
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		header := &zip.FileHeader{
+			Name:     filepath.ToSlash(name),
+			Method:   zip.Deflate,
+			Modified: dropArchiveModTime,
+		}
+		mode := fs.FileMode(0o644)
+		if fileInfo.Mode()&0o111 != 0 {
+			mode = 0o755
+		}
+		header.SetMode(mode)
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fileWriter, err := writer.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		_, err = fileWriter.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
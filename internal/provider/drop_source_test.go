@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func writeDropTestFile(t *testing.T, root, name, content string) {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func TestReadDropSource_DirectoryHashIsDeterministic(t *testing.T) {
+	root := t.TempDir()
+	writeDropTestFile(t, root, "index.html", "<h1>hello</h1>")
+	writeDropTestFile(t, root, "assets/app.js", "console.log('hi')")
+
+	archive, first, err := readDropSource(types.StringValue(root), types.StringNull())
+	if err != nil {
+		t.Fatalf("readDropSource returned error: %v", err)
+	}
+
+	// Touching files must not change the hash.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(root, "index.html"), later, later); err != nil {
+		t.Fatalf("failed to touch file: %v", err)
+	}
+	_, second, err := readDropSource(types.StringValue(root), types.StringNull())
+	if err != nil {
+		t.Fatalf("readDropSource returned error: %v", err)
+	}
+	if first != second {
+		t.Fatalf("expected identical hashes, got %s and %s", first, second)
+	}
+
+	writeDropTestFile(t, root, "index.html", "<h1>changed</h1>")
+	_, third, err := readDropSource(types.StringValue(root), types.StringNull())
+	if err != nil {
+		t.Fatalf("readDropSource returned error: %v", err)
+	}
+	if third == first {
+		t.Fatal("expected a content change to change the hash")
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("archive is not a valid zip: %v", err)
+	}
+	var names []string
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+	}
+	if len(names) != 2 || names[0] != "assets/app.js" || names[1] != "index.html" {
+		t.Fatalf("unexpected archive entries: %v", names)
+	}
+}
+
+func TestReadDropSource_Archive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "site.zip")
+	if err := os.WriteFile(path, []byte("zip-bytes"), 0o644); err != nil {
+		t.Fatalf("failed to write archive: %v", err)
+	}
+
+	content, hash, err := readDropSource(types.StringNull(), types.StringValue(path))
+	if err != nil {
+		t.Fatalf("readDropSource returned error: %v", err)
+	}
+	if string(content) != "zip-bytes" {
+		t.Fatalf("unexpected archive content: %q", content)
+	}
+	if len(hash) != 64 {
+		t.Fatalf("unexpected hash: %q", hash)
+	}
+}
+
+func TestReadDropSource_RejectsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.html")
+	if err := os.WriteFile(path, []byte("x"), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if _, _, err := readDropSource(types.StringValue(path), types.StringNull()); err == nil {
+		t.Fatal("expected an error for a source_directory that is a file")
+	}
+}
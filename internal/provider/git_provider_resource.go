@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &gitProviderResource[GitlabProviderResourceModel, client.GitlabProvider]{}
+var _ resource.ResourceWithImportState = &gitProviderResource[GitlabProviderResourceModel, client.GitlabProvider]{}
+var _ resource.ResourceWithValidateConfig = &gitProviderResource[GitlabProviderResourceModel, client.GitlabProvider]{}
+
+// gitProviderSecret is a write-only credential of a git provider resource.
+type gitProviderSecret struct {
+	Name        string
+	Description string
+}
+
+// gitProviderResourceSpec describes a GitLab, Bitbucket or Gitea provider
+// resource. M is the resource model and P the client type; the spec maps
+// between them and gitProviderResource implements everything else.
+type gitProviderResourceSpec[M any, P any] struct {
+	// SourceType is the provider's source_type, e.g. gitlab.
+	SourceType string
+	// DisplayName is used in descriptions and diagnostics, e.g. GitLab.
+	DisplayName string
+	// Attributes are the provider specific attributes besides the secrets.
+	Attributes map[string]schema.Attribute
+	// Secrets are sent from the configuration on every create and update, as
+	// they are never stored in state. credentials_version lets a change of
+	// their value alone trigger an update.
+	Secrets []gitProviderSecret
+
+	Validate   func(config M, diags *diag.Diagnostics)
+	FromPlan   func(plan, config M) P
+	ApplyState func(state M, provider *P) M
+
+	Create func(c *client.DokployClient, provider P) (*P, error)
+	Get    func(c *client.DokployClient, id string) (*P, error)
+	Update func(c *client.DokployClient, provider P) (*P, error)
+}
+
+// gitProviderResource implements the CRUD shared by the git provider
+// resources. Every model has id, git_provider_id, name and
+// credentials_version attributes.
+type gitProviderResource[M any, P any] struct {
+	client *client.DokployClient
+	spec   gitProviderResourceSpec[M, P]
+}
+
+func (r *gitProviderResource[M, P]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.spec.SourceType + "_provider"
+}
+
+func (r *gitProviderResource[M, P]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("%s integration ID, as used by %s_id on applications and compose stacks.", r.spec.DisplayName, r.spec.SourceType),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"git_provider_id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+	}
+	for name, attribute := range r.spec.Attributes {
+		attributes[name] = attribute
+	}
+
+	secretNames := make([]string, 0, len(r.spec.Secrets))
+	for _, secret := range r.spec.Secrets {
+		attributes[secret.Name] = schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: secret.Description + " Write-only: change credentials_version to send a new value.",
+		}
+		secretNames = append(secretNames, secret.Name)
+	}
+	attributes["credentials_version"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Arbitrary version of %s. Changing it sends the current values to Dokploy.", strings.Join(secretNames, " and ")),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Manages a %s integration that applications and compose stacks can deploy from via %s_id.", r.spec.DisplayName, r.spec.SourceType),
+		Attributes:  attributes,
+	}
+}
+
+func (r *gitProviderResource[M, P]) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config M
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.spec.Validate(config, &resp.Diagnostics)
+}
+
+func (r *gitProviderResource[M, P]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *gitProviderResource[M, P]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.spec.Create(r.client, r.spec.FromPlan(plan, config))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating %s provider", r.spec.DisplayName), err.Error())
+		return
+	}
+
+	plan = r.spec.ApplyState(plan, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *gitProviderResource[M, P]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state M
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, err := r.spec.Get(r.client, id.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %s provider", r.spec.DisplayName), err.Error())
+		return
+	}
+
+	state = r.spec.ApplyState(state, provider)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *gitProviderResource[M, P]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.spec.Update(r.client, r.spec.FromPlan(plan, config))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating %s provider", r.spec.DisplayName), err.Error())
+		return
+	}
+
+	plan = r.spec.ApplyState(plan, updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *gitProviderResource[M, P]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var gitProviderID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("git_provider_id"), &gitProviderID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGitProvider(gitProviderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting %s provider", r.spec.DisplayName), err.Error())
+		return
+	}
+}
+
+func (r *gitProviderResource[M, P]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestGitProviderResource_UpdateSendsWriteOnlySecretsFromConfig(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gitlab.update":
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("failed to decode payload: %v", err)
+			}
+			_, _ = w.Write([]byte(`true`))
+		case "/gitlab.one":
+			_, _ = w.Write([]byte(`{"gitlabId":"gl-1","gitProviderId":"gp-1","gitlabUrl":"https://gitlab.com","gitProvider":{"name":"gitlab"}}`))
+		default:
+			t.Errorf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := NewGitlabProviderResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client.NewDokployClient(server.URL, "test-key")}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	value := func(accessToken interface{}, credentialsVersion int64) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, "gl-1"),
+			"git_provider_id":     tftypes.NewValue(tftypes.String, "gp-1"),
+			"name":                tftypes.NewValue(tftypes.String, "gitlab"),
+			"gitlab_url":          tftypes.NewValue(tftypes.String, "https://gitlab.com"),
+			"application_id":      tftypes.NewValue(tftypes.String, nil),
+			"redirect_uri":        tftypes.NewValue(tftypes.String, nil),
+			"group_name":          tftypes.NewValue(tftypes.String, nil),
+			"secret":              tftypes.NewValue(tftypes.String, nil),
+			"access_token":        tftypes.NewValue(tftypes.String, accessToken),
+			"credentials_version": tftypes.NewValue(tftypes.Number, credentialsVersion),
+		})
+	}
+
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value("glpat-rotated", 2)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(nil, 2)},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: value(nil, 1)},
+	}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: value(nil, 1)}}
+	r.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if payload["accessToken"] != "glpat-rotated" || payload["gitlabId"] != "gl-1" || payload["gitProviderId"] != "gp-1" {
+		t.Fatalf("unexpected update payload: %#v", payload)
+	}
+	var state GitlabProviderResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !state.AccessToken.IsNull() {
+		t.Fatalf("expected the write-only access token to stay out of state, got %s", state.AccessToken)
+	}
+	if state.CredentialsVersion.ValueInt64() != 2 {
+		t.Fatalf("expected credentials_version 2 in state, got %s", state.CredentialsVersion)
+	}
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+// gitProviders lists the source types backed by a GitLab, Bitbucket or Gitea
+// provider, with the name used in attribute descriptions.
+var gitProviders = []struct {
+	SourceType string
+	Name       string
+}{
+	{SourceType: "gitlab", Name: "GitLab"},
+	{SourceType: "bitbucket", Name: "Bitbucket"},
+	{SourceType: "gitea", Name: "Gitea"},
+}
+
+// gitProviderSourceFields points at the <provider>_* attributes of a resource
+// model, so applications and compose stacks can share the conversion code.
+// BuildPath and ProjectID are nil when the resource or provider has no such
+// attribute.
+type gitProviderSourceFields struct {
+	ID         *types.String
+	Repository *types.String
+	Owner      *types.String
+	Branch     *types.String
+	BuildPath  *types.String
+	WatchPaths *types.List
+	ProjectID  *types.Int64
+}
+
+// gitProviderSchemaAttributes returns the <provider>_* attributes for every
+// git provider. Compose stacks locate their file through compose_path and set
+// withBuildPath to false.
+func gitProviderSchemaAttributes(withBuildPath bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{}
+	for _, provider := range gitProviders {
+		prefix := provider.SourceType + "_"
+		attributes[prefix+"id"] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("ID of the %s provider configured in Dokploy. Required when source_type is %s.", provider.Name, provider.SourceType),
+		}
+		attributes[prefix+"repository"] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("%s repository name. Setting it selects source_type %s when source_type is omitted.", provider.Name, provider.SourceType),
+		}
+		attributes[prefix+"owner"] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("User, group or workspace that owns the %s repository.", provider.Name),
+		}
+		attributes[prefix+"branch"] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("%s branch to deploy.", provider.Name),
+		}
+		if withBuildPath {
+			attributes[prefix+"build_path"] = schema.StringAttribute{
+				Optional:    true,
+				Description: "Path inside the repository used as build root. Defaults to /.",
+			}
+		}
+		attributes[prefix+"watch_paths"] = schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Paths that trigger an automatic deployment when changed by a push.",
+		}
+	}
+	attributes["gitlab_project_id"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "Numeric GitLab project ID. Needed by some self-hosted GitLab instances to resolve the repository.",
+	}
+	return attributes
+}
+
+// inferGitProviderSourceType returns the first git provider whose repository
+// is configured, or an empty string.
+func inferGitProviderSourceType(fields func(string) gitProviderSourceFields) string {
+	for _, provider := range gitProviders {
+		if optionalStringFromPlan(*fields(provider.SourceType).Repository) != "" {
+			return provider.SourceType
+		}
+	}
+	return ""
+}
+
+// validateGitProviderSource requires the provider ID, repository and owner
+// for a known git provider source_type.
+func validateGitProviderSource(sourceType types.String, fields func(string) gitProviderSourceFields, diags *diag.Diagnostics) {
+	if sourceType.IsNull() || sourceType.IsUnknown() || !client.IsGitProviderSourceType(sourceType.ValueString()) {
+		return
+	}
+	requireGitProviderAttributes(sourceType.ValueString(), fields(sourceType.ValueString()), diags)
+}
+
+// requireGitProviderAttributes reports the missing <provider>_id,
+// <provider>_repository and <provider>_owner attributes.
+func requireGitProviderAttributes(provider string, source gitProviderSourceFields, diags *diag.Diagnostics) {
+	required := []struct {
+		name  string
+		value types.String
+	}{
+		{name: "id", value: *source.ID},
+		{name: "repository", value: *source.Repository},
+		{name: "owner", value: *source.Owner},
+	}
+	for _, attribute := range required {
+		if attribute.value.IsNull() {
+			name := provider + "_" + attribute.name
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing Git Provider Attribute",
+				fmt.Sprintf("%s is required for %s sources.", name, provider),
+			)
+		}
+	}
+}
+
+// gitProviderSourceFromPlan converts the planned <provider>_* attributes into
+// the settings sent to Dokploy.
+func gitProviderSourceFromPlan(ctx context.Context, provider string, fields gitProviderSourceFields, enableSubmodules types.Bool) (client.GitProviderSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	source := client.GitProviderSource{
+		Provider:         provider,
+		ProviderID:       optionalStringFromPlan(*fields.ID),
+		Repository:       optionalStringFromPlan(*fields.Repository),
+		Owner:            optionalStringFromPlan(*fields.Owner),
+		Branch:           optionalStringFromPlan(*fields.Branch),
+		EnableSubmodules: enableSubmodules.ValueBool(),
+	}
+	if fields.BuildPath != nil {
+		source.BuildPath = optionalStringFromPlan(*fields.BuildPath)
+	}
+	if fields.ProjectID != nil {
+		source.ProjectID = optionalInt64PointerFromPlan(*fields.ProjectID)
+	}
+	if !fields.WatchPaths.IsNull() && !fields.WatchPaths.IsUnknown() {
+		diags.Append(fields.WatchPaths.ElementsAs(ctx, &source.WatchPaths, false)...)
+	}
+	return source, diags
+}
+
+// refreshGitProviderState updates the configured <provider>_* attributes from
+// the API. Attributes that are null in state stay null.
+func refreshGitProviderState(ctx context.Context, fields gitProviderSourceFields, source client.GitProviderSource) diag.Diagnostics {
+	var diags diag.Diagnostics
+	refresh := func(current *types.String, value string) {
+		if current == nil || current.IsNull() {
+			return
+		}
+		if value != "" {
+			*current = types.StringValue(value)
+		} else {
+			*current = types.StringNull()
+		}
+	}
+	refresh(fields.ID, source.ProviderID)
+	refresh(fields.Repository, source.Repository)
+	refresh(fields.Owner, source.Owner)
+	refresh(fields.Branch, source.Branch)
+	refresh(fields.BuildPath, source.BuildPath)
+
+	if fields.ProjectID != nil && !fields.ProjectID.IsNull() {
+		if source.ProjectID != nil {
+			*fields.ProjectID = types.Int64Value(*source.ProjectID)
+		} else {
+			*fields.ProjectID = types.Int64Null()
+		}
+	}
+	if !fields.WatchPaths.IsNull() {
+		if len(source.WatchPaths) > 0 {
+			watchPaths, d := types.ListValueFrom(ctx, types.StringType, source.WatchPaths)
+			diags.Append(d...)
+			if !d.HasError() {
+				*fields.WatchPaths = watchPaths
+			}
+		} else {
+			*fields.WatchPaths = types.ListNull(types.StringType)
+		}
+	}
+	return diags
+}
+
+// refreshActiveGitProviderState sets every <provider>_* attribute of the
+// source an application deploys from. Empty values and the default build
+// path of an unconfigured attribute are null.
+func refreshActiveGitProviderState(ctx context.Context, fields gitProviderSourceFields, source client.GitProviderSource) diag.Diagnostics {
+	*fields.ID = reportedStringState(*fields.ID, source.ProviderID, "")
+	*fields.Repository = reportedStringState(*fields.Repository, source.Repository, "")
+	*fields.Owner = reportedStringState(*fields.Owner, source.Owner, "")
+	*fields.Branch = reportedStringState(*fields.Branch, source.Branch, "")
+	if fields.BuildPath != nil {
+		*fields.BuildPath = reportedStringState(*fields.BuildPath, source.BuildPath, "/")
+	}
+	if fields.ProjectID != nil {
+		*fields.ProjectID = reportedInt64State(*fields.ProjectID, source.ProjectID, 0)
+	}
+	var diags diag.Diagnostics
+	*fields.WatchPaths, diags = reportedStringListState(ctx, *fields.WatchPaths, source.WatchPaths)
+	return diags
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestValidateGitProviderSource_RequiresIDRepositoryAndOwner(t *testing.T) {
+	config := ComposeResourceModel{
+		SourceType:      types.StringValue("gitea"),
+		GiteaRepository: types.StringValue("stack"),
+	}
+
+	var diags diag.Diagnostics
+	validateGitProviderSource(config.SourceType, config.gitProviderSourceFields, &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected errors for gitea_id and gitea_owner, got %v", diags)
+	}
+
+	config.GiteaID = types.StringValue("gitea-1")
+	config.GiteaOwner = types.StringValue("ops")
+	diags = nil
+	validateGitProviderSource(config.SourceType, config.gitProviderSourceFields, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+}
+
+func TestInferGitProviderSourceType(t *testing.T) {
+	plan := ApplicationResourceModel{BitbucketRepository: types.StringValue("web")}
+	if got := inferGitProviderSourceType(plan.gitProviderSourceFields); got != "bitbucket" {
+		t.Fatalf("expected bitbucket, got %q", got)
+	}
+	if got := inferGitProviderSourceType((&ApplicationResourceModel{}).gitProviderSourceFields); got != "" {
+		t.Fatalf("expected no provider, got %q", got)
+	}
+}
+
+func TestRefreshGitProviderState_KeepsUnconfiguredAttributesNull(t *testing.T) {
+	ctx := context.Background()
+	state := ApplicationResourceModel{
+		GitlabRepository: types.StringValue("old"),
+		GitlabBranch:     types.StringValue("main"),
+		GitlabWatchPaths: types.ListNull(types.StringType),
+		GitlabProjectID:  types.Int64Value(1),
+	}
+	projectID := int64(42)
+	diags := refreshGitProviderState(ctx, state.gitProviderSourceFields("gitlab"), client.GitProviderSource{
+		Provider:   "gitlab",
+		ProviderID: "gitlab-1",
+		Repository: "api",
+		Branch:     "",
+		WatchPaths: []string{"api/**"},
+		ProjectID:  &projectID,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if state.GitlabRepository.ValueString() != "api" || state.GitlabProjectID.ValueInt64() != 42 {
+		t.Fatalf("configured attributes were not refreshed: %#v", state)
+	}
+	if !state.GitlabBranch.IsNull() {
+		t.Fatalf("expected a branch cleared in Dokploy to become null, got %v", state.GitlabBranch)
+	}
+	if !state.GitlabID.IsNull() || !state.GitlabWatchPaths.IsNull() {
+		t.Fatalf("expected unconfigured attributes to stay null, got id=%v watch_paths=%v", state.GitlabID, state.GitlabWatchPaths)
+	}
+}
+
+func TestComposeGitSourceFromPlan(t *testing.T) {
+	ctx := context.Background()
+
+	plan := ComposeResourceModel{SourceType: types.StringValue("github")}
+	source, diags := composeGitSourceFromPlan(ctx, plan)
+	if diags.HasError() || source != nil {
+		t.Fatalf("expected no source without github_id, got %#v (%v)", source, diags)
+	}
+
+	plan.GithubID = types.StringValue("gh-1")
+	plan.GithubRepository = types.StringValue("stack")
+	plan.GithubOwner = types.StringValue("acme")
+	plan.TriggerType = types.StringValue("tag")
+	plan.EnableSubmodules = types.BoolValue(true)
+	source, diags = composeGitSourceFromPlan(ctx, plan)
+	if diags.HasError() || source == nil {
+		t.Fatalf("expected a github source, got %#v (%v)", source, diags)
+	}
+	if source.ProviderID != "gh-1" || source.Repository != "stack" || source.TriggerType != "tag" || !source.EnableSubmodules {
+		t.Fatalf("unexpected source: %#v", source)
+	}
+
+	plan = ComposeResourceModel{SourceType: types.StringValue("raw")}
+	if source, _ := composeGitSourceFromPlan(ctx, plan); source != nil {
+		t.Fatalf("expected no source for raw compose stacks, got %#v", source)
+	}
+}
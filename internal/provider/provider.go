@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,14 +16,18 @@ import (
 
 var _ provider.Provider = &DokployProvider{}
 var _ provider.ProviderWithFunctions = &DokployProvider{}
+var _ provider.ProviderWithActions = &DokployProvider{}
 
 type DokployProvider struct {
 	version string
 }
 
 type DokployProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Host                  types.String  `tfsdk:"host"`
+	ApiKey                types.String  `tfsdk:"api_key"`
+	DisableRequestCache   types.Bool    `tfsdk:"disable_request_cache"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 func (p *DokployProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,6 +47,18 @@ func (p *DokployProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Sensitive:   true,
 				Description: "Your Dokploy API Key",
 			},
+			"disable_request_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, disables caching and deduplication of read requests (project.one, application.one, compose.one) within a single Terraform operation.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum sustained rate of API requests per second. Unlimited when unset or 0.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests in flight at once. Unlimited when unset or 0.",
+			},
 		},
 	}
 }
@@ -67,16 +85,39 @@ func (p *DokployProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.RequestsPerSecond.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Requests Per Second",
+			"requests_per_second must be greater than or equal to 0.",
+		)
+	}
+	if config.MaxConcurrentRequests.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Max Concurrent Requests",
+			"max_concurrent_requests must be greater than or equal to 0.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if config.Host.IsNull() || config.ApiKey.IsNull() {
 		return
 	}
 
 	// Create client
 	c := client.NewDokployClient(config.Host.ValueString(), config.ApiKey.ValueString())
+	if config.DisableRequestCache.ValueBool() {
+		c.DisableRequestCache()
+	}
+	c.SetRateLimit(config.RequestsPerSecond.ValueFloat64(), int(config.MaxConcurrentRequests.ValueInt64()))
 
 	// Make client available to resources
 	resp.ResourceData = c
 	resp.DataSourceData = c
+	resp.ActionData = c
 }
 
 func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -85,20 +126,44 @@ func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewEnvironmentResource,
 		NewApplicationResource,
 		NewComposeResource,
+		NewComposeTemplateResource,
 		NewDatabaseResource,
 		NewBackupDestinationResource,
 		NewDomainResource,
 		NewPortResource,
+		NewMountResource,
 		NewEnvironmentVariablesResource,
 		NewProjectEnvironmentVariablesResource,
 		NewSSHKeyResource,
 		NewVolumeBackupResource,
 		NewTraefikConfigResource,
+		NewApplicationTraefikConfigResource,
+		NewGitlabProviderResource,
+		NewBitbucketProviderResource,
+		NewGiteaProviderResource,
 	}
 }
 
 func (p *DokployProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewDeploymentsDataSource,
+		NewDeploymentLogDataSource,
+		NewGitProvidersDataSource,
+		NewGitProviderDataSource,
+		NewComposeServicesDataSource,
+		NewComposeTemplatesDataSource,
+	}
+}
+
+func (p *DokployProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewApplicationDeployAction,
+		NewApplicationRollbackAction,
+		NewApplicationStopAction,
+		NewComposeDeployAction,
+		NewDatabaseRestartAction,
+		NewTraefikReloadAction,
+	}
 }
 
 func (p *DokployProvider) Functions(_ context.Context) []func() function.Function {
@@ -2,14 +2,17 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +21,8 @@ import (
 
 var _ resource.Resource = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{}
@@ -45,8 +50,17 @@ type ApplicationResourceModel struct {
 	SourceType                            types.String `tfsdk:"source_type"`
 	Username                              types.String `tfsdk:"username"`
 	Password                              types.String `tfsdk:"password"`
+	PasswordWO                            types.String `tfsdk:"password_wo"`
+	PasswordVersion                       types.Int64  `tfsdk:"password_version"`
+	DockerImage                           types.String `tfsdk:"docker_image"`
+	RegistryURL                           types.String `tfsdk:"registry_url"`
+	SourceDirectory                       types.String `tfsdk:"source_directory"`
+	SourceArchive                         types.String `tfsdk:"source_archive"`
+	SourceHash                            types.String `tfsdk:"source_hash"`
 	AutoDeploy                            types.Bool   `tfsdk:"auto_deploy"`
 	DeployOnCreate                        types.Bool   `tfsdk:"deploy_on_create"`
+	WaitForDeployment                     types.Bool   `tfsdk:"wait_for_deployment"`
+	RedeployTriggers                      types.Map    `tfsdk:"redeploy_triggers"`
 	IsPreviewDeploymentsActive            types.Bool   `tfsdk:"is_preview_deployments_active"`
 	PreviewWildcard                       types.String `tfsdk:"preview_wildcard"`
 	PreviewPort                           types.Int64  `tfsdk:"preview_port"`
@@ -60,6 +74,23 @@ type ApplicationResourceModel struct {
 	PreviewBuildArgs                      types.String `tfsdk:"preview_build_args"`
 	PreviewLabels                         types.List   `tfsdk:"preview_labels"`
 	Labels                                types.Map    `tfsdk:"labels"`
+	BuildArgs                             types.Map    `tfsdk:"build_args"`
+	BuildSecrets                          types.Map    `tfsdk:"build_secrets"`
+	RollbackActive                        types.Bool   `tfsdk:"rollback_active"`
+	RollbackRegistryID                    types.String `tfsdk:"rollback_registry_id"`
+	MemoryLimit                           types.String `tfsdk:"memory_limit"`
+	MemoryReservation                     types.String `tfsdk:"memory_reservation"`
+	CPULimit                              types.String `tfsdk:"cpu_limit"`
+	CPUReservation                        types.String `tfsdk:"cpu_reservation"`
+	Replicas                              types.Int64  `tfsdk:"replicas"`
+	Command                               types.String `tfsdk:"command"`
+	SwarmHealthCheck                      types.Object `tfsdk:"swarm_health_check"`
+	SwarmRestartPolicy                    types.Object `tfsdk:"swarm_restart_policy"`
+	SwarmPlacement                        types.Object `tfsdk:"swarm_placement"`
+	SwarmUpdateConfig                     types.Object `tfsdk:"swarm_update_config"`
+	SwarmRollbackConfig                   types.Object `tfsdk:"swarm_rollback_config"`
+	SwarmMode                             types.Object `tfsdk:"swarm_mode"`
+	SwarmNetworks                         types.List   `tfsdk:"swarm_networks"`
 	// GitHub Provider fields
 	GithubRepository types.String `tfsdk:"github_repository"`
 	GithubOwner      types.String `tfsdk:"github_owner"`
@@ -71,6 +102,28 @@ type ApplicationResourceModel struct {
 	TriggerType      types.String `tfsdk:"trigger_type"`
 	Ports            types.List   `tfsdk:"ports"`
 	Mounts           types.List   `tfsdk:"mounts"`
+	// GitLab, Bitbucket and Gitea Provider fields
+	GitlabRepository    types.String `tfsdk:"gitlab_repository"`
+	GitlabOwner         types.String `tfsdk:"gitlab_owner"`
+	GitlabBranch        types.String `tfsdk:"gitlab_branch"`
+	GitlabBuildPath     types.String `tfsdk:"gitlab_build_path"`
+	GitlabID            types.String `tfsdk:"gitlab_id"`
+	GitlabWatchPaths    types.List   `tfsdk:"gitlab_watch_paths"`
+	GitlabProjectID     types.Int64  `tfsdk:"gitlab_project_id"`
+	BitbucketRepository types.String `tfsdk:"bitbucket_repository"`
+	BitbucketOwner      types.String `tfsdk:"bitbucket_owner"`
+	BitbucketBranch     types.String `tfsdk:"bitbucket_branch"`
+	BitbucketBuildPath  types.String `tfsdk:"bitbucket_build_path"`
+	BitbucketID         types.String `tfsdk:"bitbucket_id"`
+	BitbucketWatchPaths types.List   `tfsdk:"bitbucket_watch_paths"`
+	GiteaRepository     types.String `tfsdk:"gitea_repository"`
+	GiteaOwner          types.String `tfsdk:"gitea_owner"`
+	GiteaBranch         types.String `tfsdk:"gitea_branch"`
+	GiteaBuildPath      types.String `tfsdk:"gitea_build_path"`
+	GiteaID             types.String `tfsdk:"gitea_id"`
+	GiteaWatchPaths     types.List   `tfsdk:"gitea_watch_paths"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ApplicationPortResourceModel struct {
@@ -86,6 +139,17 @@ type ApplicationMountResourceModel struct {
 	VolumeName types.String `tfsdk:"volume_name"`
 }
 
+var applicationPortAttrTypes = map[string]attr.Type{
+	"published_port": types.Int64Type,
+	"target_port":    types.Int64Type,
+	"protocol":       types.StringType,
+	"publish_mode":   types.StringType,
+}
+
+var applicationPortObjectType = types.ObjectType{
+	AttrTypes: applicationPortAttrTypes,
+}
+
 var applicationMountAttrTypes = map[string]attr.Type{
 	"mount_type":  types.StringType,
 	"mount_path":  types.StringType,
@@ -109,6 +173,432 @@ func normalizeApplicationMountPlan(plan ApplicationMountResourceModel) client.Mo
 	}
 }
 
+func normalizeApplicationPortPlan(plan ApplicationPortResourceModel) client.Port {
+	protocol := strings.TrimSpace(plan.Protocol.ValueString())
+	if plan.Protocol.IsUnknown() || plan.Protocol.IsNull() || protocol == "" {
+		protocol = "tcp"
+	}
+	publishMode := strings.TrimSpace(plan.PublishMode.ValueString())
+	if plan.PublishMode.IsUnknown() || plan.PublishMode.IsNull() || publishMode == "" {
+		publishMode = "ingress"
+	}
+
+	return client.Port{
+		PublishedPort: plan.PublishedPort.ValueInt64(),
+		TargetPort:    plan.TargetPort.ValueInt64(),
+		Protocol:      protocol,
+		PublishMode:   publishMode,
+	}
+}
+
+func applicationPortsFromList(ctx context.Context, list types.List) ([]client.Port, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	var models []ApplicationPortResourceModel
+	diags := list.ElementsAs(ctx, &models, false)
+	ports := make([]client.Port, 0, len(models))
+	for _, model := range models {
+		ports = append(ports, normalizeApplicationPortPlan(model))
+	}
+	return ports, diags
+}
+
+func applicationMountsFromList(ctx context.Context, list types.List) ([]client.Mount, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	var models []ApplicationMountResourceModel
+	diags := list.ElementsAs(ctx, &models, false)
+	mounts := make([]client.Mount, 0, len(models))
+	for _, model := range models {
+		mounts = append(mounts, normalizeApplicationMountPlan(model))
+	}
+	return mounts, diags
+}
+
+// applicationMountsToList turns normalized mounts back into the mounts
+// attribute so computed mount types are known after apply.
+func applicationMountsToList(mounts []client.Mount) (types.List, diag.Diagnostics) {
+	values := make([]attr.Value, 0, len(mounts))
+	for _, mount := range mounts {
+		values = append(values, types.ObjectValueMust(applicationMountAttrTypes, map[string]attr.Value{
+			"mount_type":  types.StringValue(mount.MountType),
+			"mount_path":  types.StringValue(mount.MountPath),
+			"volume_name": types.StringValue(mount.VolumeName),
+		}))
+	}
+	return types.ListValue(applicationMountObjectType, values)
+}
+
+func applicationPortKey(port client.Port) string {
+	protocol := strings.ToLower(strings.TrimSpace(port.Protocol))
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	return fmt.Sprintf("%d/%s", port.PublishedPort, protocol)
+}
+
+func applicationMountKey(mount client.Mount) string {
+	return strings.TrimSuffix(strings.TrimSpace(mount.MountPath), "/")
+}
+
+type applicationPortChanges struct {
+	Create []client.Port
+	Update []client.Port
+	Delete []client.Port
+}
+
+// diffApplicationPorts matches planned ports by published port and protocol
+// against those of the application's ports that were previously managed
+// inline, as managedApplicationPortsState does on read. Ports owned by
+// dokploy_port resources are therefore never updated or deleted, even when a
+// planned port has the same key.
+func diffApplicationPorts(planned, previous, actual []client.Port) applicationPortChanges {
+	managedKeys := make(map[string]bool, len(previous))
+	for _, port := range previous {
+		managedKeys[applicationPortKey(port)] = true
+	}
+	actualByKey := make(map[string]client.Port, len(actual))
+	for _, port := range actual {
+		if key := applicationPortKey(port); managedKeys[key] {
+			actualByKey[key] = port
+		}
+	}
+
+	var changes applicationPortChanges
+	plannedKeys := make(map[string]bool, len(planned))
+	for _, port := range planned {
+		key := applicationPortKey(port)
+		plannedKeys[key] = true
+		existing, ok := actualByKey[key]
+		if !ok {
+			changes.Create = append(changes.Create, port)
+			continue
+		}
+		if existing.TargetPort != port.TargetPort || !strings.EqualFold(existing.PublishMode, port.PublishMode) {
+			port.ID = existing.ID
+			changes.Update = append(changes.Update, port)
+		}
+	}
+
+	for _, port := range previous {
+		key := applicationPortKey(port)
+		if plannedKeys[key] {
+			continue
+		}
+		if existing, ok := actualByKey[key]; ok {
+			changes.Delete = append(changes.Delete, existing)
+			delete(actualByKey, key)
+		}
+	}
+	return changes
+}
+
+type applicationMountChanges struct {
+	Create []client.Mount
+	Delete []client.Mount
+}
+
+// diffApplicationMounts matches planned mounts against the application's
+// mounts by mount path. A mount whose type or volume changed is replaced, and
+// only previously managed paths are removed; Read keeps previous limited to
+// those, see managedApplicationMountsState.
+func diffApplicationMounts(planned, previous, actual []client.Mount) applicationMountChanges {
+	actualByPath := make(map[string]client.Mount, len(actual))
+	for _, mount := range actual {
+		actualByPath[applicationMountKey(mount)] = mount
+	}
+
+	var changes applicationMountChanges
+	plannedPaths := make(map[string]bool, len(planned))
+	for _, mount := range planned {
+		key := applicationMountKey(mount)
+		plannedPaths[key] = true
+		existing, ok := actualByPath[key]
+		if !ok {
+			changes.Create = append(changes.Create, mount)
+			continue
+		}
+		if !client.MountMatches(existing, mount.MountType, mount.MountPath, mount.VolumeName) {
+			changes.Delete = append(changes.Delete, existing)
+			changes.Create = append(changes.Create, mount)
+		}
+	}
+
+	for _, mount := range previous {
+		key := applicationMountKey(mount)
+		if plannedPaths[key] {
+			continue
+		}
+		if existing, ok := actualByPath[key]; ok {
+			changes.Delete = append(changes.Delete, existing)
+			delete(actualByPath, key)
+		}
+	}
+	return changes
+}
+
+// managedApplicationPortsState refreshes the inline ports in current from the
+// application's ports. Like managedApplicationMountsState it only reports
+// ports already in state, so ports of dokploy_port resources are not deleted
+// on the next apply. Unset protocol and publish_mode stay null while Dokploy
+// reports their defaults.
+func managedApplicationPortsState(ctx context.Context, current types.List, actual []client.Port) (types.List, diag.Diagnostics) {
+	if current.IsNull() || current.IsUnknown() {
+		return current, nil
+	}
+	var models []ApplicationPortResourceModel
+	diags := current.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return current, diags
+	}
+
+	actualByKey := make(map[string]client.Port, len(actual))
+	for _, port := range actual {
+		actualByKey[applicationPortKey(port)] = port
+	}
+	values := make([]attr.Value, 0, len(models))
+	for _, model := range models {
+		existing, ok := actualByKey[applicationPortKey(normalizeApplicationPortPlan(model))]
+		if !ok {
+			continue
+		}
+		values = append(values, types.ObjectValueMust(applicationPortAttrTypes, map[string]attr.Value{
+			"published_port": types.Int64Value(existing.PublishedPort),
+			"target_port":    types.Int64Value(existing.TargetPort),
+			"protocol":       reportedPortSettingState(model.Protocol, existing.Protocol, "tcp"),
+			"publish_mode":   reportedPortSettingState(model.PublishMode, existing.PublishMode, "ingress"),
+		}))
+	}
+
+	list, d := types.ListValue(applicationPortObjectType, values)
+	diags.Append(d...)
+	return list, diags
+}
+
+// reportedPortSettingState keeps the state spelling of a case-insensitive
+// port setting while it matches the reported value.
+func reportedPortSettingState(current types.String, value, defaultValue string) types.String {
+	if value == "" {
+		value = defaultValue
+	}
+	if current.IsNull() || current.IsUnknown() || strings.TrimSpace(current.ValueString()) == "" {
+		if strings.EqualFold(value, defaultValue) {
+			return current
+		}
+		return types.StringValue(value)
+	}
+	if strings.EqualFold(strings.TrimSpace(current.ValueString()), value) {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+// managedApplicationMountsState refreshes the inline mounts in current from
+// the application's mounts. Only mount paths already in state are reported:
+// mounts added in the UI or by dokploy_mount resources are not managed inline
+// and would otherwise be deleted on the next apply. Mounts that still match
+// their state keep it, since Dokploy prefixes volume names.
+func managedApplicationMountsState(ctx context.Context, current types.List, actual []client.Mount) (types.List, diag.Diagnostics) {
+	if current.IsNull() || current.IsUnknown() {
+		return current, nil
+	}
+	managed, diags := applicationMountsFromList(ctx, current)
+	if diags.HasError() {
+		return current, diags
+	}
+
+	actualByPath := make(map[string]client.Mount, len(actual))
+	for _, mount := range actual {
+		actualByPath[applicationMountKey(mount)] = mount
+	}
+	mounts := make([]client.Mount, 0, len(managed))
+	for _, mount := range managed {
+		existing, ok := actualByPath[applicationMountKey(mount)]
+		if !ok {
+			continue
+		}
+		if !client.MountMatches(existing, mount.MountType, mount.MountPath, mount.VolumeName) {
+			mount.MountType = existing.EffectiveType()
+			if mount.MountType == "" {
+				mount.MountType = "volume"
+			}
+			mount.VolumeName = existing.VolumeName
+		}
+		mounts = append(mounts, mount)
+	}
+
+	list, d := applicationMountsToList(mounts)
+	diags.Append(d...)
+	return list, diags
+}
+
+// registryPasswordFromPlan returns the registry password to send: the
+// write-only password_wo when configured, otherwise the deprecated password.
+func registryPasswordFromPlan(plan ApplicationResourceModel, passwordWO types.String) types.String {
+	if !passwordWO.IsNull() && !passwordWO.IsUnknown() {
+		return passwordWO
+	}
+	return plan.Password
+}
+
+// dockerProviderChanged reports whether the docker source settings differ
+// from state. password_wo is not in state, so a new value is only sent when
+// password_version changes.
+func dockerProviderChanged(plan, state ApplicationResourceModel) bool {
+	return !plan.SourceType.Equal(state.SourceType) ||
+		!plan.DockerImage.Equal(state.DockerImage) ||
+		!plan.RegistryURL.Equal(state.RegistryURL) ||
+		!plan.Username.Equal(state.Username) ||
+		!plan.Password.Equal(state.Password) ||
+		!plan.PasswordVersion.Equal(state.PasswordVersion)
+}
+
+func dockerProviderFromPlan(plan ApplicationResourceModel, password types.String) client.DockerProvider {
+	return client.DockerProvider{
+		DockerImage: optionalStringFromPlan(plan.DockerImage),
+		Username:    optionalStringFromPlan(plan.Username),
+		Password:    optionalStringFromPlan(password),
+		RegistryURL: optionalStringFromPlan(plan.RegistryURL),
+	}
+}
+
+// gitProviderSourceFields returns the application's attributes for a
+// GitLab, Bitbucket or Gitea source.
+func (m *ApplicationResourceModel) gitProviderSourceFields(provider string) gitProviderSourceFields {
+	switch provider {
+	case "gitlab":
+		return gitProviderSourceFields{ID: &m.GitlabID, Repository: &m.GitlabRepository, Owner: &m.GitlabOwner, Branch: &m.GitlabBranch, BuildPath: &m.GitlabBuildPath, WatchPaths: &m.GitlabWatchPaths, ProjectID: &m.GitlabProjectID}
+	case "bitbucket":
+		return gitProviderSourceFields{ID: &m.BitbucketID, Repository: &m.BitbucketRepository, Owner: &m.BitbucketOwner, Branch: &m.BitbucketBranch, BuildPath: &m.BitbucketBuildPath, WatchPaths: &m.BitbucketWatchPaths}
+	default:
+		return gitProviderSourceFields{ID: &m.GiteaID, Repository: &m.GiteaRepository, Owner: &m.GiteaOwner, Branch: &m.GiteaBranch, BuildPath: &m.GiteaBuildPath, WatchPaths: &m.GiteaWatchPaths}
+	}
+}
+
+// githubProviderConfigFromPlan builds the application.saveGithubProvider
+// payload. Unset settings are sent as their defaults so removing them from
+// the configuration clears them.
+func githubProviderConfigFromPlan(ctx context.Context, plan ApplicationResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	config := map[string]interface{}{
+		"githubId":         plan.GithubID.ValueString(),
+		"owner":            nil,
+		"buildPath":        "/",
+		"triggerType":      "push",
+		"watchPaths":       []string{},
+		"enableSubmodules": plan.EnableSubmodules.ValueBool(),
+	}
+	if !plan.GithubRepository.IsNull() && !plan.GithubRepository.IsUnknown() {
+		config["repository"] = plan.GithubRepository.ValueString()
+	}
+	if !plan.GithubBranch.IsNull() && !plan.GithubBranch.IsUnknown() {
+		config["branch"] = plan.GithubBranch.ValueString()
+	}
+	if owner := optionalStringFromPlan(plan.GithubOwner); owner != "" {
+		config["owner"] = owner
+	}
+	if buildPath := optionalStringFromPlan(plan.GithubBuildPath); buildPath != "" {
+		config["buildPath"] = buildPath
+	}
+	if triggerType := optionalStringFromPlan(plan.TriggerType); triggerType != "" {
+		config["triggerType"] = triggerType
+	}
+	var diags diag.Diagnostics
+	if !plan.GithubWatchPaths.IsNull() && !plan.GithubWatchPaths.IsUnknown() {
+		var watchPaths []string
+		diags = plan.GithubWatchPaths.ElementsAs(ctx, &watchPaths, false)
+		if len(watchPaths) > 0 {
+			config["watchPaths"] = watchPaths
+		}
+	}
+	return config, diags
+}
+
+// refreshApplicationGithubState refreshes the github_* attributes of an
+// application deployed from GitHub. Dokploy stores the repository and branch
+// in the columns also reported as repository_url and branch.
+func refreshApplicationGithubState(ctx context.Context, state *ApplicationResourceModel, app *client.Application) diag.Diagnostics {
+	repository, branch := app.GithubRepository, app.GithubBranch
+	if repository == "" {
+		repository = app.RepositoryURL
+	}
+	if branch == "" {
+		branch = app.Branch
+	}
+	state.GithubID = reportedStringState(state.GithubID, app.GithubID, "")
+	state.GithubRepository = reportedStringState(state.GithubRepository, repository, "")
+	state.GithubOwner = reportedStringState(state.GithubOwner, app.GithubOwner, "")
+	state.GithubBranch = reportedStringState(state.GithubBranch, branch, "")
+	state.GithubBuildPath = reportedStringState(state.GithubBuildPath, app.GithubBuildPath, "/")
+	state.TriggerType = reportedStringState(state.TriggerType, app.TriggerType, "push")
+
+	var diags diag.Diagnostics
+	state.GithubWatchPaths, diags = reportedStringListState(ctx, state.GithubWatchPaths, app.GithubWatchPaths)
+	return diags
+}
+
+// optionalStringState keeps unset optional attributes null when Dokploy
+// reports an empty value for them.
+func optionalStringState(current types.String, value string) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// reportedStringState returns the state of an optional attribute that Dokploy
+// reports as value. Empty values are null unless an empty string is
+// configured, and so is defaultValue unless the attribute is configured, so
+// settings Dokploy fills in on its own do not show up as drift.
+func reportedStringState(current types.String, value, defaultValue string) types.String {
+	if value == "" && !current.IsNull() && !current.IsUnknown() && current.ValueString() == "" {
+		return current
+	}
+	if value == "" || (current.IsNull() && value == defaultValue) {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func reportedBoolState(current types.Bool, value *bool, defaultValue bool) types.Bool {
+	if value == nil || (current.IsNull() && *value == defaultValue) {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*value)
+}
+
+func reportedInt64State(current types.Int64, value *int64, defaultValue int64) types.Int64 {
+	if value == nil || (current.IsNull() && *value == defaultValue) {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*value)
+}
+
+// reportedStringListState returns null for an empty list unless an empty list
+// is configured.
+func reportedStringListState(ctx context.Context, current types.List, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		if !current.IsNull() && !current.IsUnknown() && len(current.Elements()) == 0 {
+			return current, nil
+		}
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+// reportedStringMapState returns null for an empty map unless an empty map is
+// configured.
+func reportedStringMapState(ctx context.Context, current types.Map, values map[string]string) (types.Map, diag.Diagnostics) {
+	if len(values) == 0 {
+		if !current.IsNull() && !current.IsUnknown() && len(current.Elements()) == 0 {
+			return current, nil
+		}
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
 func optionalStringFromPlan(value types.String) string {
 	if value.IsUnknown() || value.IsNull() {
 		return ""
@@ -132,11 +622,31 @@ func optionalInt64PointerFromPlan(value types.Int64) *int64 {
 	return &result
 }
 
+// optionalStringMapFromPlan converts a configured map attribute into a Go map.
+// Null and unknown values yield a nil map so callers can leave the remote value untouched.
+func optionalStringMapFromPlan(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	if value.IsUnknown() || value.IsNull() {
+		return nil, nil
+	}
+	result := map[string]string{}
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// buildEnvMapForUpdate resolves the build args/secrets map to send on update.
+// Removing the attribute from config clears the remote value.
+func buildEnvMapForUpdate(ctx context.Context, plan, state types.Map) (map[string]string, diag.Diagnostics) {
+	if plan.IsNull() && !state.IsNull() {
+		return map[string]string{}, nil
+	}
+	return optionalStringMapFromPlan(ctx, plan)
+}
+
 func (r *ApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
 
-func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ApplicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -200,11 +710,44 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed: true,
 			},
 			"username": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Registry username for docker sources.",
 			},
 			"password": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
+				Optional:           true,
+				Sensitive:          true,
+				Description:        "Registry password for docker sources. Stored in state; conflicts with password_wo.",
+				DeprecationMessage: "Use password_wo instead, which is never stored in state. password will be removed in a future major version.",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Registry password for docker sources. Conflicts with password. Write-only: it is never stored in state, so change password_version to send a new value. Requires Terraform 1.11 or later.",
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Arbitrary version of password_wo. Changing it sends the current password to Dokploy.",
+			},
+			"docker_image": schema.StringAttribute{
+				Optional:    true,
+				Description: "Image to run when source_type is docker, e.g. ghcr.io/acme/api:1.2.3.",
+			},
+			"registry_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Registry that hosts docker_image, e.g. ghcr.io. Defaults to Docker Hub.",
+			},
+			"source_directory": schema.StringAttribute{
+				Optional:    true,
+				Description: "Local directory that is zipped and uploaded when source_type is drop. Conflicts with source_archive.",
+			},
+			"source_archive": schema.StringAttribute{
+				Optional:    true,
+				Description: "Local zip file that is uploaded when source_type is drop. Conflicts with source_directory.",
+			},
+			"source_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the uploaded archive. A change uploads and deploys the new source.",
 			},
 			"auto_deploy": schema.BoolAttribute{
 				Optional: true,
@@ -213,6 +756,15 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"deploy_on_create": schema.BoolAttribute{
 				Optional: true,
 			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, waits for deployments triggered by this resource to finish and fails the apply when the deployment fails.",
+			},
+			"redeploy_triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that trigger a redeploy when they change, for example hashes of environment variables or IDs of related domains.",
+			},
 			"is_preview_deployments_active": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -254,11 +806,52 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"build_args": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Build-time arguments passed to the image build (Docker --build-arg).",
+			},
+			"build_secrets": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Build-time secrets exposed to the image build (Docker --secret), e.g. NPM_TOKEN.",
+			},
+			"rollback_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, each deployment's image is pushed to the rollback registry so it can be restored later.",
+			},
+			"rollback_registry_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the Dokploy registry that stores rollback images.",
+			},
+			"memory_limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "Hard memory limit in Docker format, e.g. 512m or 1g. A plain number is a byte count.",
+			},
+			"memory_reservation": schema.StringAttribute{
+				Optional:    true,
+				Description: "Soft memory limit in Docker format, e.g. 256m.",
+			},
+			"cpu_limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum number of CPUs, as accepted by docker run --cpus, e.g. 0.5 or 2.",
+			},
+			"cpu_reservation": schema.StringAttribute{
+				Optional:    true,
+				Description: "Number of CPUs reserved for the application, e.g. 0.25.",
+			},
+			"replicas": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of replicas of the application's Swarm service.",
+			},
+			"command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command that overrides the image's default command.",
+			},
 			"ports": schema.ListNestedAttribute{
-				Optional: true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
+				Optional:    true,
+				Description: "Ports published by the application. Changes are applied in place and redeploy an already deployed application. Other ports, such as those of dokploy_port resources, are left alone. A published port managed both here and by dokploy_port is not supported.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"published_port": schema.Int64Attribute{
@@ -277,10 +870,8 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"mounts": schema.ListNestedAttribute{
-				Optional: true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
+				Optional:    true,
+				Description: "Volumes mounted into the application. Changes are applied in place and redeploy an already deployed application. Mounts on other paths, such as those of dokploy_mount resources, are left alone.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"mount_type": schema.StringAttribute{
@@ -322,7 +913,153 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+	for name, attribute := range applicationSwarmSchemaAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+	for name, attribute := range gitProviderSchemaAttributes(true) {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (r *ApplicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplicationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memoryLimit := validateResourceQuantity(config.MemoryLimit, path.Root("memory_limit"), "Invalid Memory Value", parseDockerMemory, &resp.Diagnostics)
+	memoryReservation := validateResourceQuantity(config.MemoryReservation, path.Root("memory_reservation"), "Invalid Memory Value", parseDockerMemory, &resp.Diagnostics)
+	cpuLimit := validateResourceQuantity(config.CPULimit, path.Root("cpu_limit"), "Invalid CPU Value", parseDockerCPUs, &resp.Diagnostics)
+	cpuReservation := validateResourceQuantity(config.CPUReservation, path.Root("cpu_reservation"), "Invalid CPU Value", parseDockerCPUs, &resp.Diagnostics)
+
+	if memoryLimit > 0 && memoryReservation > memoryLimit {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("memory_reservation"),
+			"Invalid Memory Reservation",
+			"memory_reservation must not be greater than memory_limit.",
+		)
+	}
+	if cpuLimit > 0 && cpuReservation > cpuLimit {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cpu_reservation"),
+			"Invalid CPU Reservation",
+			"cpu_reservation must not be greater than cpu_limit.",
+		)
+	}
+	if !config.Replicas.IsNull() && !config.Replicas.IsUnknown() && config.Replicas.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("replicas"),
+			"Invalid Replicas",
+			"replicas must be greater than or equal to 0.",
+		)
+	}
+	validateApplicationSwarm(ctx, config, &resp.Diagnostics)
+
+	if !config.Password.IsNull() && !config.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_wo"),
+			"Conflicting Attributes",
+			"password and password_wo cannot both be set. Move the value to password_wo.",
+		)
+	}
+
+	if !config.SourceDirectory.IsNull() && !config.SourceArchive.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_archive"),
+			"Conflicting Drop Sources",
+			"Only one of source_directory and source_archive can be set.",
+		)
+	}
+	hasDropSource := !config.SourceDirectory.IsNull() || !config.SourceArchive.IsNull()
+	if hasDropSource && !config.SourceType.IsNull() && !config.SourceType.IsUnknown() && config.SourceType.ValueString() != "drop" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_type"),
+			"Invalid Source Type",
+			"source_directory and source_archive can only be used when source_type is drop.",
+		)
+	}
+	if config.SourceType.ValueString() == "drop" && !hasDropSource {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_type"),
+			"Missing Drop Source",
+			"source_directory or source_archive is required when source_type is drop.",
+		)
+	}
+	if config.SourceType.ValueString() == "docker" && config.DockerImage.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("docker_image"),
+			"Missing Docker Image",
+			"docker_image is required when source_type is docker.",
+		)
+	}
+	validateGitProviderSource(config.SourceType, config.gitProviderSourceFields, &resp.Diagnostics)
+}
+
+// validateResourceQuantity reports an attribute error when a known memory or
+// CPU value cannot be parsed, and returns the parsed value otherwise.
+func validateResourceQuantity(value types.String, attributePath path.Path, summary string, parse func(string) (int64, error), diags *diag.Diagnostics) int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+	parsed, err := parse(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, summary, err.Error())
+		return 0
+	}
+	return parsed
+}
+
+// ModifyPlan hashes the drop source at plan time so changed files show up as
+// a diff on source_hash.
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var directory, archive types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source_directory"), &directory)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source_archive"), &archive)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	hash := types.StringNull()
+	switch {
+	case directory.IsUnknown() || archive.IsUnknown():
+		hash = types.StringUnknown()
+	case !directory.IsNull() || !archive.IsNull():
+		_, sum, err := readDropSource(directory, archive)
+		if errors.Is(err, fs.ErrNotExist) {
+			// The source may be generated during apply.
+			hash = types.StringUnknown()
+		} else if err != nil {
+			resp.Diagnostics.AddError("Error reading application source", err.Error())
+			return
+		} else {
+			hash = types.StringValue(sum)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_hash"), hash)...)
+}
+
+func (r *ApplicationResource) uploadDropSource(appID string, plan ApplicationResourceModel) (string, error) {
+	archive, hash, err := readDropSource(plan.SourceDirectory, plan.SourceArchive)
+	if err != nil {
+		return "", err
+	}
+	if err := r.client.DropDeployApplication(appID, archive); err != nil {
+		return "", err
+	}
+	return hash, nil
 }
 
 func (r *ApplicationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -345,6 +1082,15 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// password_wo is write-only, so it is only available in the configuration.
+	var passwordWO types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	password := registryPasswordFromPlan(plan, passwordWO)
+
 	if plan.Branch.IsUnknown() || plan.Branch.IsNull() {
 		plan.Branch = types.StringValue("main")
 	}
@@ -397,6 +1143,14 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
+	buildArgs, diags := optionalStringMapFromPlan(ctx, plan.BuildArgs)
+	resp.Diagnostics.Append(diags...)
+	buildSecrets, diags := optionalStringMapFromPlan(ctx, plan.BuildSecrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	autoDeployConfigured := !plan.AutoDeploy.IsNull() && !plan.AutoDeploy.IsUnknown()
 	desiredAutoDeploy := false
 	if autoDeployConfigured {
@@ -410,8 +1164,14 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Default SourceType logic
 	if plan.SourceType.IsUnknown() || plan.SourceType.IsNull() {
-		if !plan.CustomGitUrl.IsNull() && !plan.CustomGitUrl.IsUnknown() && plan.CustomGitUrl.ValueString() != "" {
+		if optionalStringFromPlan(plan.DockerImage) != "" {
+			plan.SourceType = types.StringValue("docker")
+		} else if !plan.SourceDirectory.IsNull() || !plan.SourceArchive.IsNull() {
+			plan.SourceType = types.StringValue("drop")
+		} else if !plan.CustomGitUrl.IsNull() && !plan.CustomGitUrl.IsUnknown() && plan.CustomGitUrl.ValueString() != "" {
 			plan.SourceType = types.StringValue("git")
+		} else if provider := inferGitProviderSourceType(plan.gitProviderSourceFields); provider != "" {
+			plan.SourceType = types.StringValue(provider)
 		} else {
 			plan.SourceType = types.StringValue("github")
 		}
@@ -433,7 +1193,9 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		CustomGitBuildPath:                    plan.CustomGitBuildPath.ValueString(),
 		SourceType:                            plan.SourceType.ValueString(),
 		Username:                              plan.Username.ValueString(),
-		Password:                              plan.Password.ValueString(),
+		Password:                              password.ValueString(),
+		DockerImage:                           optionalStringFromPlan(plan.DockerImage),
+		RegistryURL:                           optionalStringFromPlan(plan.RegistryURL),
 		AutoDeploy:                            createAutoDeploy,
 		IsPreviewDeploymentsActive:            optionalBoolPointerFromPlan(plan.IsPreviewDeploymentsActive),
 		PreviewWildcard:                       optionalStringFromPlan(plan.PreviewWildcard),
@@ -448,6 +1210,19 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		PreviewBuildArgs:                      optionalStringFromPlan(plan.PreviewBuildArgs),
 		PreviewLabels:                         previewLabels,
 		LabelsSwarm:                           labels,
+		RollbackActive:                        optionalBoolPointerFromPlan(plan.RollbackActive),
+		RollbackRegistryID:                    optionalStringFromPlan(plan.RollbackRegistryID),
+		MemoryLimit:                           client.ResourceQuantity(memoryQuantityFromPlan(plan.MemoryLimit)),
+		MemoryReservation:                     client.ResourceQuantity(memoryQuantityFromPlan(plan.MemoryReservation)),
+		CPULimit:                              client.ResourceQuantity(cpuQuantityFromPlan(plan.CPULimit)),
+		CPUReservation:                        client.ResourceQuantity(cpuQuantityFromPlan(plan.CPUReservation)),
+		Replicas:                              optionalInt64PointerFromPlan(plan.Replicas),
+		Command:                               optionalStringFromPlan(plan.Command),
+	}
+	diags = applicationSwarmFromPlan(ctx, plan, &app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	createdApp, err := r.client.CreateApplication(app)
@@ -486,22 +1261,9 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	for i, portPlan := range managedPorts {
-		protocol := strings.TrimSpace(portPlan.Protocol.ValueString())
-		if portPlan.Protocol.IsUnknown() || portPlan.Protocol.IsNull() || protocol == "" {
-			protocol = "tcp"
-		}
-		publishMode := strings.TrimSpace(portPlan.PublishMode.ValueString())
-		if portPlan.PublishMode.IsUnknown() || portPlan.PublishMode.IsNull() || publishMode == "" {
-			publishMode = "ingress"
-		}
-
-		_, err := r.client.CreatePort(client.Port{
-			ApplicationID: createdApp.ID,
-			PublishedPort: portPlan.PublishedPort.ValueInt64(),
-			TargetPort:    portPlan.TargetPort.ValueInt64(),
-			Protocol:      protocol,
-			PublishMode:   publishMode,
-		})
+		managedPort := normalizeApplicationPortPlan(portPlan)
+		managedPort.ApplicationID = createdApp.ID
+		_, err := r.client.CreatePort(managedPort)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating application port",
@@ -527,40 +1289,38 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
-	// Save GitHub provider if GitHub fields are provided
-	if !plan.GithubID.IsNull() && !plan.GithubID.IsUnknown() && plan.GithubID.ValueString() != "" {
-		githubConfig := map[string]interface{}{
-			"enableSubmodules": plan.EnableSubmodules.ValueBool(),
-		}
-
-		if !plan.GithubRepository.IsNull() && !plan.GithubRepository.IsUnknown() {
-			githubConfig["repository"] = plan.GithubRepository.ValueString()
-		}
-		if !plan.GithubBranch.IsNull() && !plan.GithubBranch.IsUnknown() {
-			githubConfig["branch"] = plan.GithubBranch.ValueString()
-		}
-		if !plan.GithubOwner.IsNull() && !plan.GithubOwner.IsUnknown() {
-			githubConfig["owner"] = plan.GithubOwner.ValueString()
-		}
-		if !plan.GithubBuildPath.IsNull() && !plan.GithubBuildPath.IsUnknown() {
-			githubConfig["buildPath"] = plan.GithubBuildPath.ValueString()
+	if plan.SourceType.ValueString() == "docker" {
+		if err := r.client.SaveDockerProvider(createdApp.ID, dockerProviderFromPlan(plan, password)); err != nil {
+			resp.Diagnostics.AddError(
+				"Error saving application docker provider",
+				fmt.Sprintf("Application %s was created but saving the docker image settings failed: %s", createdApp.ID, err.Error()),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
 		}
-		if !plan.GithubID.IsNull() && !plan.GithubID.IsUnknown() {
-			githubConfig["githubId"] = plan.GithubID.ValueString()
+	}
+	if provider := plan.SourceType.ValueString(); client.IsGitProviderSourceType(provider) {
+		source, diags := gitProviderSourceFromPlan(ctx, provider, plan.gitProviderSourceFields(provider), plan.EnableSubmodules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		if !plan.TriggerType.IsNull() && !plan.TriggerType.IsUnknown() {
-			githubConfig["triggerType"] = plan.TriggerType.ValueString()
-		} else {
-			githubConfig["triggerType"] = "push"
+		if err := r.client.SaveApplicationGitProvider(createdApp.ID, source); err != nil {
+			resp.Diagnostics.AddError(
+				"Error saving application git provider",
+				fmt.Sprintf("Application %s was created but saving the %s repository settings failed: %s", createdApp.ID, provider, err.Error()),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
 		}
+	}
 
-		// Handle watchPaths list
-		if !plan.GithubWatchPaths.IsNull() && !plan.GithubWatchPaths.IsUnknown() {
-			var watchPaths []string
-			diags := plan.GithubWatchPaths.ElementsAs(ctx, &watchPaths, false)
-			if !diags.HasError() && len(watchPaths) > 0 {
-				githubConfig["watchPaths"] = watchPaths
-			}
+	// Save GitHub provider if GitHub fields are provided
+	if !plan.GithubID.IsNull() && !plan.GithubID.IsUnknown() && plan.GithubID.ValueString() != "" {
+		githubConfig, diags := githubProviderConfigFromPlan(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
 		err := r.client.SaveGithubProvider(createdApp.ID, githubConfig)
@@ -570,6 +1330,19 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
+	if buildArgs != nil || buildSecrets != nil {
+		if err := r.client.SaveApplicationBuildEnv(createdApp.ID, buildArgs, buildSecrets); err != nil {
+			resp.Diagnostics.AddError(
+				"Error saving application build arguments",
+				fmt.Sprintf("Application %s was created but saving build_args/build_secrets failed: %s", createdApp.ID, err.Error()),
+			)
+			// Keep the application in state so Terraform taints it instead of
+			// losing track of it.
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
 	if (len(managedPorts) > 0 || len(managedMounts) > 0) && desiredAutoDeploy && !createdApp.AutoDeploy {
 		updatedApp, err := r.client.UpdateApplication(client.Application{
 			ID:                                    createdApp.ID,
@@ -603,6 +1376,21 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 			PreviewBuildArgs:                      app.PreviewBuildArgs,
 			PreviewLabels:                         app.PreviewLabels,
 			LabelsSwarm:                           app.LabelsSwarm,
+			RollbackActive:                        app.RollbackActive,
+			RollbackRegistryID:                    app.RollbackRegistryID,
+			MemoryLimit:                           app.MemoryLimit,
+			MemoryReservation:                     app.MemoryReservation,
+			CPULimit:                              app.CPULimit,
+			CPUReservation:                        app.CPUReservation,
+			Replicas:                              app.Replicas,
+			Command:                               app.Command,
+			HealthCheckSwarm:                      app.HealthCheckSwarm,
+			RestartPolicySwarm:                    app.RestartPolicySwarm,
+			PlacementSwarm:                        app.PlacementSwarm,
+			UpdateConfigSwarm:                     app.UpdateConfigSwarm,
+			RollbackConfigSwarm:                   app.RollbackConfigSwarm,
+			ModeSwarm:                             app.ModeSwarm,
+			NetworkSwarm:                          app.NetworkSwarm,
 		})
 		if err != nil {
 			resp.Diagnostics.AddWarning(
@@ -620,12 +1408,39 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		plan.AutoDeploy = types.BoolValue(createdApp.AutoDeploy)
 	}
 
+	// Uploading a drop source starts a deployment by itself.
+	sourceUploaded := false
+	if plan.SourceType.ValueString() == "drop" {
+		hash, err := r.uploadDropSource(createdApp.ID, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Error uploading application source", fmt.Sprintf("Application %s was created but uploading its source failed: %s", createdApp.ID, err.Error()))
+			if plan.SourceHash.IsUnknown() {
+				plan.SourceHash = types.StringNull()
+			}
+		} else {
+			plan.SourceHash = types.StringValue(hash)
+			sourceUploaded = true
+		}
+	}
+
 	shouldTriggerDeploy := !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool()
-	// For inline managed ports/mounts with deferred autoDeploy, avoid duplicate deploys.
-	if shouldTriggerDeploy && !((len(managedPorts) > 0 || len(managedMounts) > 0) && createdApp.AutoDeploy) {
+	if sourceUploaded {
+		shouldTriggerDeploy = true
+	} else if shouldTriggerDeploy && !((len(managedPorts) > 0 || len(managedMounts) > 0) && createdApp.AutoDeploy) {
+		// For inline managed ports/mounts with deferred autoDeploy, avoid duplicate deploys.
 		err := r.client.DeployApplication(createdApp.ID)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Application created but deployment failed to trigger: %s", err.Error()))
+			shouldTriggerDeploy = false
+		}
+	}
+
+	if shouldTriggerDeploy && plan.WaitForDeployment.ValueBool() {
+		createTimeout, diags := plan.Timeouts.Create(ctx, defaultDeploymentTimeout)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() {
+			// A freshly created application has no earlier deployment to skip over.
+			waitForDeployment(ctx, r.client, "application", createdApp.ID, "", createTimeout, &resp.Diagnostics)
 		}
 	}
 
@@ -713,237 +1528,71 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	// AutoDeploy is Computed boolean - always set from API
 	state.AutoDeploy = types.BoolValue(app.AutoDeploy)
 
-	// Optional custom git fields
-	if app.CustomGitUrl != "" {
-		state.CustomGitUrl = types.StringValue(app.CustomGitUrl)
-	} else if !state.CustomGitUrl.IsNull() {
-		state.CustomGitUrl = types.StringNull()
-	}
-	if app.CustomGitBranch != "" {
-		state.CustomGitBranch = types.StringValue(app.CustomGitBranch)
-	} else if !state.CustomGitBranch.IsNull() {
-		state.CustomGitBranch = types.StringNull()
-	}
-	if app.CustomGitSSHKeyId != "" {
-		state.CustomGitSSHKeyID = types.StringValue(app.CustomGitSSHKeyId)
-	} else if !state.CustomGitSSHKeyID.IsNull() {
-		state.CustomGitSSHKeyID = types.StringNull()
-	}
-	if app.CustomGitBuildPath != "" {
-		state.CustomGitBuildPath = types.StringValue(app.CustomGitBuildPath)
-	} else if !state.CustomGitBuildPath.IsNull() {
-		state.CustomGitBuildPath = types.StringNull()
-	}
-	if app.Username != "" {
-		state.Username = types.StringValue(app.Username)
-	} else if !state.Username.IsNull() {
-		state.Username = types.StringNull()
-	}
-
-	// Optional preview deployment fields - only update if they were set in config.
-	if !state.IsPreviewDeploymentsActive.IsNull() {
-		if app.IsPreviewDeploymentsActive != nil {
-			state.IsPreviewDeploymentsActive = types.BoolValue(*app.IsPreviewDeploymentsActive)
-		} else {
-			state.IsPreviewDeploymentsActive = types.BoolNull()
-		}
-	}
-	if !state.PreviewWildcard.IsNull() {
-		if app.PreviewWildcard != "" {
-			state.PreviewWildcard = types.StringValue(app.PreviewWildcard)
-		} else {
-			state.PreviewWildcard = types.StringNull()
-		}
-	}
-	if !state.PreviewPort.IsNull() {
-		if app.PreviewPort != nil {
-			state.PreviewPort = types.Int64Value(*app.PreviewPort)
-		} else {
-			state.PreviewPort = types.Int64Null()
-		}
-	}
-	if !state.PreviewPath.IsNull() {
-		if app.PreviewPath != "" {
-			state.PreviewPath = types.StringValue(app.PreviewPath)
-		} else {
-			state.PreviewPath = types.StringNull()
-		}
-	}
-	if !state.PreviewHTTPS.IsNull() {
-		if app.PreviewHTTPS != nil {
-			state.PreviewHTTPS = types.BoolValue(*app.PreviewHTTPS)
-		} else {
-			state.PreviewHTTPS = types.BoolNull()
-		}
-	}
-	if !state.PreviewCertificateType.IsNull() {
-		if app.PreviewCertificateType != "" {
-			state.PreviewCertificateType = types.StringValue(app.PreviewCertificateType)
-		} else {
-			state.PreviewCertificateType = types.StringNull()
-		}
-	}
-	if !state.PreviewCustomCertResolver.IsNull() {
-		if app.PreviewCustomCertResolver != "" {
-			state.PreviewCustomCertResolver = types.StringValue(app.PreviewCustomCertResolver)
-		} else {
-			state.PreviewCustomCertResolver = types.StringNull()
-		}
-	}
-	if !state.PreviewLimit.IsNull() {
-		if app.PreviewLimit != nil {
-			state.PreviewLimit = types.Int64Value(*app.PreviewLimit)
-		} else {
-			state.PreviewLimit = types.Int64Null()
-		}
-	}
-	if !state.PreviewRequireCollaboratorPermissions.IsNull() {
-		if app.PreviewRequireCollaboratorPermissions != nil {
-			state.PreviewRequireCollaboratorPermissions = types.BoolValue(*app.PreviewRequireCollaboratorPermissions)
-		} else {
-			state.PreviewRequireCollaboratorPermissions = types.BoolNull()
-		}
-	}
-	if !state.PreviewEnv.IsNull() {
-		if app.PreviewEnv != "" {
-			state.PreviewEnv = types.StringValue(app.PreviewEnv)
-		} else {
-			state.PreviewEnv = types.StringNull()
-		}
-	}
-	if !state.PreviewBuildArgs.IsNull() {
-		if app.PreviewBuildArgs != "" {
-			state.PreviewBuildArgs = types.StringValue(app.PreviewBuildArgs)
-		} else {
-			state.PreviewBuildArgs = types.StringNull()
-		}
-	}
-	if !state.PreviewLabels.IsNull() {
-		if len(app.PreviewLabels) > 0 {
-			previewLabels, previewLabelsDiags := types.ListValueFrom(ctx, types.StringType, app.PreviewLabels)
-			resp.Diagnostics.Append(previewLabelsDiags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			state.PreviewLabels = previewLabels
-		} else {
-			state.PreviewLabels = types.ListNull(types.StringType)
-		}
-	}
-	if !state.Labels.IsNull() {
-		if len(app.LabelsSwarm) > 0 {
-			labelsValue, labelsDiags := types.MapValueFrom(ctx, types.StringType, app.LabelsSwarm)
-			resp.Diagnostics.Append(labelsDiags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			state.Labels = labelsValue
-		} else {
-			emptyLabels, labelsDiags := types.MapValueFrom(ctx, types.StringType, map[string]string{})
-			resp.Diagnostics.Append(labelsDiags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			state.Labels = emptyLabels
-		}
-	}
-	// Don't read password back
+	// Optional fields are refreshed whether or not they are configured, so
+	// changes made in the Dokploy UI show up as drift.
+	state.CustomGitUrl = reportedStringState(state.CustomGitUrl, app.CustomGitUrl, "")
+	state.CustomGitBranch = reportedStringState(state.CustomGitBranch, app.CustomGitBranch, "")
+	state.CustomGitSSHKeyID = reportedStringState(state.CustomGitSSHKeyID, app.CustomGitSSHKeyId, "")
+	state.CustomGitBuildPath = reportedStringState(state.CustomGitBuildPath, app.CustomGitBuildPath, "")
+
+	state.IsPreviewDeploymentsActive = reportedBoolState(state.IsPreviewDeploymentsActive, app.IsPreviewDeploymentsActive, false)
+	state.PreviewWildcard = reportedStringState(state.PreviewWildcard, app.PreviewWildcard, "")
+	state.PreviewPort = reportedInt64State(state.PreviewPort, app.PreviewPort, 3000)
+	state.PreviewPath = reportedStringState(state.PreviewPath, app.PreviewPath, "/")
+	state.PreviewHTTPS = reportedBoolState(state.PreviewHTTPS, app.PreviewHTTPS, false)
+	state.PreviewCertificateType = reportedStringState(state.PreviewCertificateType, app.PreviewCertificateType, "none")
+	state.PreviewCustomCertResolver = reportedStringState(state.PreviewCustomCertResolver, app.PreviewCustomCertResolver, "")
+	state.PreviewLimit = reportedInt64State(state.PreviewLimit, app.PreviewLimit, 3)
+	state.PreviewRequireCollaboratorPermissions = reportedBoolState(state.PreviewRequireCollaboratorPermissions, app.PreviewRequireCollaboratorPermissions, true)
+	state.PreviewEnv = reportedStringState(state.PreviewEnv, app.PreviewEnv, "")
+	state.PreviewBuildArgs = reportedStringState(state.PreviewBuildArgs, app.PreviewBuildArgs, "")
+	state.PreviewLabels, diags = reportedStringListState(ctx, state.PreviewLabels, app.PreviewLabels)
+	resp.Diagnostics.Append(diags...)
 
-	// Optional GitHub Provider fields - only update if they were set in config
-	// If state has a value (was configured), update it based on API response
-	// If state was null (not configured), keep it null
-	if !state.GithubRepository.IsNull() {
-		if app.GithubRepository != "" {
-			state.GithubRepository = types.StringValue(app.GithubRepository)
-		} else {
-			state.GithubRepository = types.StringNull()
-		}
-	}
-	if !state.GithubOwner.IsNull() {
-		if app.GithubOwner != "" {
-			state.GithubOwner = types.StringValue(app.GithubOwner)
-		} else {
-			state.GithubOwner = types.StringNull()
-		}
-	}
-	if !state.GithubBranch.IsNull() {
-		if app.GithubBranch != "" {
-			state.GithubBranch = types.StringValue(app.GithubBranch)
-		} else {
-			state.GithubBranch = types.StringNull()
-		}
-	}
-	if !state.GithubBuildPath.IsNull() {
-		if app.GithubBuildPath != "" {
-			state.GithubBuildPath = types.StringValue(app.GithubBuildPath)
-		} else {
-			state.GithubBuildPath = types.StringNull()
-		}
-	}
-	if !state.GithubID.IsNull() {
-		if app.GithubID != "" {
-			state.GithubID = types.StringValue(app.GithubID)
-		} else {
-			state.GithubID = types.StringNull()
-		}
-	}
-	if !state.TriggerType.IsNull() {
-		if app.TriggerType != "" {
-			state.TriggerType = types.StringValue(app.TriggerType)
-		} else {
-			state.TriggerType = types.StringNull()
-		}
-	}
-	if !state.GithubWatchPaths.IsNull() {
-		if len(app.GithubWatchPaths) > 0 {
-			watchPathsList, diags := types.ListValueFrom(ctx, types.StringType, app.GithubWatchPaths)
-			if !diags.HasError() {
-				state.GithubWatchPaths = watchPathsList
-			}
-		} else {
-			state.GithubWatchPaths = types.ListNull(types.StringType)
-		}
+	state.RollbackActive = reportedBoolState(state.RollbackActive, app.RollbackActive, false)
+	state.RollbackRegistryID = reportedStringState(state.RollbackRegistryID, app.RollbackRegistryID, "")
+	state.MemoryLimit = memoryQuantityState(state.MemoryLimit, string(app.MemoryLimit))
+	state.MemoryReservation = memoryQuantityState(state.MemoryReservation, string(app.MemoryReservation))
+	state.CPULimit = cpuQuantityState(state.CPULimit, string(app.CPULimit))
+	state.CPUReservation = cpuQuantityState(state.CPUReservation, string(app.CPUReservation))
+	state.Replicas = reportedInt64State(state.Replicas, app.Replicas, 1)
+	state.Command = reportedStringState(state.Command, app.Command, "")
+	resp.Diagnostics.Append(refreshApplicationSwarmState(ctx, &state, app)...)
+
+	state.Labels, diags = reportedStringMapState(ctx, state.Labels, app.LabelsSwarm)
+	resp.Diagnostics.Append(diags...)
+	state.BuildArgs, diags = reportedStringMapState(ctx, state.BuildArgs, client.ParseEnv(app.BuildArgs))
+	resp.Diagnostics.Append(diags...)
+	state.BuildSecrets, diags = reportedStringMapState(ctx, state.BuildSecrets, client.ParseEnv(app.BuildSecrets))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// EnableSubmodules - optional boolean, only update if it was set in config
-	if !state.EnableSubmodules.IsNull() {
-		state.EnableSubmodules = types.BoolValue(app.EnableSubmodules)
+	// Dokploy keeps the settings of earlier sources when source_type changes
+	// and ignores them, so only the active source's attributes are refreshed.
+	// Dokploy does not return the registry password, so password and
+	// password_wo are never read back.
+	switch app.SourceType {
+	case "docker":
+		state.DockerImage = reportedStringState(state.DockerImage, app.DockerImage, "")
+		state.RegistryURL = reportedStringState(state.RegistryURL, app.RegistryURL, "")
+		state.Username = reportedStringState(state.Username, app.Username, "")
+	case "github":
+		resp.Diagnostics.Append(refreshApplicationGithubState(ctx, &state, app)...)
+	default:
+		if client.IsGitProviderSourceType(app.SourceType) {
+			resp.Diagnostics.Append(refreshActiveGitProviderState(ctx, state.gitProviderSourceFields(app.SourceType), app.GitProviderSource(app.SourceType))...)
+		}
 	}
+	state.EnableSubmodules = reportedBoolState(state.EnableSubmodules, &app.EnableSubmodules, false)
 
-	// Optional mounts - only update if mounts were configured in Terraform.
-	if !state.Mounts.IsNull() {
-		if len(app.Mounts) == 0 {
-			state.Mounts = types.ListNull(applicationMountObjectType)
-		} else {
-			mountObjects := make([]attr.Value, 0, len(app.Mounts))
-			for _, mount := range app.Mounts {
-				mountType := strings.TrimSpace(mount.MountType)
-				if mountType == "" {
-					mountType = "volume"
-				}
-
-				mountObj, mountDiags := types.ObjectValue(
-					applicationMountAttrTypes,
-					map[string]attr.Value{
-						"mount_type":  types.StringValue(mountType),
-						"mount_path":  types.StringValue(mount.MountPath),
-						"volume_name": types.StringValue(mount.VolumeName),
-					},
-				)
-				resp.Diagnostics.Append(mountDiags...)
-				if resp.Diagnostics.HasError() {
-					return
-				}
-				mountObjects = append(mountObjects, mountObj)
-			}
-
-			mountsList, mountDiags := types.ListValue(applicationMountObjectType, mountObjects)
-			resp.Diagnostics.Append(mountDiags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			state.Mounts = mountsList
-		}
+	state.Ports, diags = managedApplicationPortsState(ctx, state.Ports, app.Ports)
+	resp.Diagnostics.Append(diags...)
+	state.Mounts, diags = managedApplicationMountsState(ctx, state.Mounts, app.Mounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -965,10 +1614,30 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// password_wo is write-only, so it is only available in the configuration.
+	var passwordWO types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	password := registryPasswordFromPlan(plan, passwordWO)
+
 	if plan.ID.IsUnknown() || plan.ID.IsNull() || plan.ID.ValueString() == "" {
 		plan.ID = state.ID
 	}
 
+	if plan.SourceType.IsUnknown() || plan.SourceType.IsNull() {
+		if optionalStringFromPlan(plan.DockerImage) != "" {
+			plan.SourceType = types.StringValue("docker")
+		} else if !plan.SourceDirectory.IsNull() || !plan.SourceArchive.IsNull() {
+			plan.SourceType = types.StringValue("drop")
+		} else if provider := inferGitProviderSourceType(plan.gitProviderSourceFields); provider != "" {
+			plan.SourceType = types.StringValue(provider)
+		} else {
+			plan.SourceType = state.SourceType
+		}
+	}
 	if plan.Branch.IsUnknown() {
 		plan.Branch = types.StringValue("main")
 	}
@@ -1004,6 +1673,14 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
+	buildArgs, diags := buildEnvMapForUpdate(ctx, plan.BuildArgs, state.BuildArgs)
+	resp.Diagnostics.Append(diags...)
+	buildSecrets, diags := buildEnvMapForUpdate(ctx, plan.BuildSecrets, state.BuildSecrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	app := client.Application{
 		ID:                                    plan.ID.ValueString(),
 		Name:                                  plan.Name.ValueString(),
@@ -1021,7 +1698,9 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		CustomGitBuildPath:                    plan.CustomGitBuildPath.ValueString(),
 		SourceType:                            plan.SourceType.ValueString(),
 		Username:                              plan.Username.ValueString(),
-		Password:                              plan.Password.ValueString(),
+		Password:                              password.ValueString(),
+		DockerImage:                           optionalStringFromPlan(plan.DockerImage),
+		RegistryURL:                           optionalStringFromPlan(plan.RegistryURL),
 		AutoDeploy:                            plan.AutoDeploy.ValueBool(),
 		IsPreviewDeploymentsActive:            optionalBoolPointerFromPlan(plan.IsPreviewDeploymentsActive),
 		PreviewWildcard:                       optionalStringFromPlan(plan.PreviewWildcard),
@@ -1036,6 +1715,19 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		PreviewBuildArgs:                      optionalStringFromPlan(plan.PreviewBuildArgs),
 		PreviewLabels:                         previewLabels,
 		LabelsSwarm:                           labels,
+		RollbackActive:                        optionalBoolPointerFromPlan(plan.RollbackActive),
+		RollbackRegistryID:                    optionalStringFromPlan(plan.RollbackRegistryID),
+		MemoryLimit:                           client.ResourceQuantity(memoryQuantityFromPlan(plan.MemoryLimit)),
+		MemoryReservation:                     client.ResourceQuantity(memoryQuantityFromPlan(plan.MemoryReservation)),
+		CPULimit:                              client.ResourceQuantity(cpuQuantityFromPlan(plan.CPULimit)),
+		CPUReservation:                        client.ResourceQuantity(cpuQuantityFromPlan(plan.CPUReservation)),
+		Replicas:                              optionalInt64PointerFromPlan(plan.Replicas),
+		Command:                               optionalStringFromPlan(plan.Command),
+	}
+	diags = applicationSwarmFromPlan(ctx, plan, &app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	updatedApp, err := r.client.UpdateApplication(app)
@@ -1048,40 +1740,35 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.EnvironmentID = types.StringValue(updatedApp.EnvironmentID)
 	plan.AutoDeploy = types.BoolValue(updatedApp.AutoDeploy)
 
-	// Update GitHub provider if GitHub fields are provided
-	if !plan.GithubID.IsNull() && !plan.GithubID.IsUnknown() && plan.GithubID.ValueString() != "" {
-		githubConfig := map[string]interface{}{
-			"enableSubmodules": plan.EnableSubmodules.ValueBool(),
-		}
+	inlineChanged := r.reconcileInlinePortsAndMounts(ctx, updatedApp.ID, &plan, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		if !plan.GithubRepository.IsNull() && !plan.GithubRepository.IsUnknown() {
-			githubConfig["repository"] = plan.GithubRepository.ValueString()
-		}
-		if !plan.GithubBranch.IsNull() && !plan.GithubBranch.IsUnknown() {
-			githubConfig["branch"] = plan.GithubBranch.ValueString()
-		}
-		if !plan.GithubOwner.IsNull() && !plan.GithubOwner.IsUnknown() {
-			githubConfig["owner"] = plan.GithubOwner.ValueString()
-		}
-		if !plan.GithubBuildPath.IsNull() && !plan.GithubBuildPath.IsUnknown() {
-			githubConfig["buildPath"] = plan.GithubBuildPath.ValueString()
+	if plan.SourceType.ValueString() == "docker" && dockerProviderChanged(plan, state) {
+		if err := r.client.SaveDockerProvider(updatedApp.ID, dockerProviderFromPlan(plan, password)); err != nil {
+			resp.Diagnostics.AddError("Error updating application docker provider", err.Error())
+			return
 		}
-		if !plan.GithubID.IsNull() && !plan.GithubID.IsUnknown() {
-			githubConfig["githubId"] = plan.GithubID.ValueString()
+	}
+	if provider := plan.SourceType.ValueString(); client.IsGitProviderSourceType(provider) {
+		source, diags := gitProviderSourceFromPlan(ctx, provider, plan.gitProviderSourceFields(provider), plan.EnableSubmodules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		if !plan.TriggerType.IsNull() && !plan.TriggerType.IsUnknown() {
-			githubConfig["triggerType"] = plan.TriggerType.ValueString()
-		} else {
-			githubConfig["triggerType"] = "push"
+		if err := r.client.SaveApplicationGitProvider(updatedApp.ID, source); err != nil {
+			resp.Diagnostics.AddError("Error updating application git provider", err.Error())
+			return
 		}
+	}
 
-		// Handle watchPaths list
-		if !plan.GithubWatchPaths.IsNull() && !plan.GithubWatchPaths.IsUnknown() {
-			var watchPaths []string
-			diags := plan.GithubWatchPaths.ElementsAs(ctx, &watchPaths, false)
-			if !diags.HasError() && len(watchPaths) > 0 {
-				githubConfig["watchPaths"] = watchPaths
-			}
+	// Update GitHub provider if GitHub fields are provided
+	if !plan.GithubID.IsNull() && !plan.GithubID.IsUnknown() && plan.GithubID.ValueString() != "" {
+		githubConfig, diags := githubProviderConfigFromPlan(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
 		err := r.client.SaveGithubProvider(updatedApp.ID, githubConfig)
@@ -1091,10 +1778,125 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
+	if buildArgs != nil || buildSecrets != nil {
+		if err := r.client.SaveApplicationBuildEnv(updatedApp.ID, buildArgs, buildSecrets); err != nil {
+			resp.Diagnostics.AddError("Error updating application build arguments", err.Error())
+			return
+		}
+	}
+
+	// A successful upload starts a deployment by itself. A failed one does
+	// not, so a redeploy asked for below still has to be triggered.
+	sourceUploaded := false
+	if plan.SourceType.ValueString() == "drop" && !plan.SourceHash.Equal(state.SourceHash) {
+		previousID := r.client.LatestApplicationDeploymentID(updatedApp.ID)
+		hash, err := r.uploadDropSource(updatedApp.ID, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Error uploading application source", err.Error())
+			// Keep the previous hash so the next apply retries the upload.
+			plan.SourceHash = state.SourceHash
+		} else {
+			sourceUploaded = true
+			plan.SourceHash = types.StringValue(hash)
+			if plan.WaitForDeployment.ValueBool() {
+				updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeploymentTimeout)
+				resp.Diagnostics.Append(diags...)
+				if !diags.HasError() {
+					waitForDeployment(ctx, r.client, "application", updatedApp.ID, previousID, updateTimeout, &resp.Diagnostics)
+				}
+			}
+		}
+	}
+
+	// Port and mount changes only reach the containers on the next deploy.
+	// Applications that were never deployed pick them up on their first one.
+	redeployForInline := inlineChanged && r.client.LatestApplicationDeploymentID(updatedApp.ID) != ""
+	if !sourceUploaded && (redeployTriggersChanged(plan.RedeployTriggers, state.RedeployTriggers) || redeployForInline) {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeploymentTimeout)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || !redeployTarget(ctx, r.client, "application", updatedApp.ID, plan.WaitForDeployment.ValueBool(), updateTimeout, &resp.Diagnostics) {
+			// Keep the previous triggers so the next apply retries the redeploy.
+			plan.RedeployTriggers = state.RedeployTriggers
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
+// reconcileInlinePortsAndMounts applies the difference between the planned
+// and actual inline ports and mounts. It reports whether anything changed.
+func (r *ApplicationResource) reconcileInlinePortsAndMounts(ctx context.Context, appID string, plan *ApplicationResourceModel, state ApplicationResourceModel, diags *diag.Diagnostics) bool {
+	plannedPorts, d := applicationPortsFromList(ctx, plan.Ports)
+	diags.Append(d...)
+	previousPorts, d := applicationPortsFromList(ctx, state.Ports)
+	diags.Append(d...)
+	plannedMounts, d := applicationMountsFromList(ctx, plan.Mounts)
+	diags.Append(d...)
+	previousMounts, d := applicationMountsFromList(ctx, state.Mounts)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+
+	if !plan.Mounts.IsNull() && !plan.Mounts.IsUnknown() {
+		plan.Mounts, d = applicationMountsToList(plannedMounts)
+		diags.Append(d...)
+	}
+
+	if len(plannedPorts) == 0 && len(previousPorts) == 0 && len(plannedMounts) == 0 && len(previousMounts) == 0 {
+		return false
+	}
+
+	app, err := r.client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Error reading application", err.Error())
+		return false
+	}
+
+	portChanges := diffApplicationPorts(plannedPorts, previousPorts, app.Ports)
+	mountChanges := diffApplicationMounts(plannedMounts, previousMounts, app.Mounts)
+
+	for _, port := range portChanges.Delete {
+		port.ApplicationID = appID
+		if err := r.client.DeletePort(port); err != nil {
+			diags.AddError("Error deleting application port", fmt.Sprintf("failed deleting port %d/%s from application %s: %s", port.PublishedPort, port.Protocol, appID, err.Error()))
+			return false
+		}
+	}
+	for _, port := range portChanges.Update {
+		port.ApplicationID = appID
+		if _, err := r.client.UpdatePort(port); err != nil {
+			diags.AddError("Error updating application port", fmt.Sprintf("failed updating port %d/%s on application %s: %s", port.PublishedPort, port.Protocol, appID, err.Error()))
+			return false
+		}
+	}
+	for _, port := range portChanges.Create {
+		port.ApplicationID = appID
+		if _, err := r.client.CreatePort(port); err != nil {
+			diags.AddError("Error creating application port", fmt.Sprintf("failed creating port %d/%s on application %s: %s", port.PublishedPort, port.Protocol, appID, err.Error()))
+			return false
+		}
+	}
+	for _, mount := range mountChanges.Delete {
+		mount.ServiceType = "application"
+		mount.ServiceID = appID
+		if err := r.client.DeleteMount(mount); err != nil {
+			diags.AddError("Error deleting application mount", fmt.Sprintf("failed deleting mount %s from application %s: %s", mount.MountPath, appID, err.Error()))
+			return false
+		}
+	}
+	for _, mount := range mountChanges.Create {
+		mount.ApplicationID = appID
+		if _, err := r.client.CreateMount(mount); err != nil {
+			diags.AddError("Error creating application mount", fmt.Sprintf("failed creating mount %s on application %s: %s", mount.MountPath, appID, err.Error()))
+			return false
+		}
+	}
+
+	return len(portChanges.Create)+len(portChanges.Update)+len(portChanges.Delete)+len(mountChanges.Create)+len(mountChanges.Delete) > 0
+}
+
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state ApplicationResourceModel
 	diags := req.State.Get(ctx, &state)
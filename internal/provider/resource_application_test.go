@@ -1,9 +1,18 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
 )
 
 func TestNormalizeApplicationMountPlan_DefaultsMountTypeToVolume(t *testing.T) {
@@ -69,3 +78,511 @@ func TestOptionalInt64PointerFromPlan(t *testing.T) {
 		t.Fatalf("expected pointer to 0, got %#v", got)
 	}
 }
+
+func TestReportedStringState(t *testing.T) {
+	if got := reportedStringState(types.StringNull(), "/", "/"); !got.IsNull() {
+		t.Fatalf("expected default of an unconfigured attribute to be null, got %s", got)
+	}
+	if got := reportedStringState(types.StringValue("/"), "/", "/"); got.ValueString() != "/" {
+		t.Fatalf("expected configured default to be kept, got %s", got)
+	}
+	if got := reportedStringState(types.StringNull(), "/preview", "/"); got.ValueString() != "/preview" {
+		t.Fatalf("expected value set in Dokploy to be reported, got %s", got)
+	}
+	if got := reportedStringState(types.StringValue("/preview"), "", "/"); !got.IsNull() {
+		t.Fatalf("expected value removed in Dokploy to be null, got %s", got)
+	}
+	if got := reportedStringState(types.StringValue(""), "", ""); got.IsNull() || got.ValueString() != "" {
+		t.Fatalf("expected configured empty string to be kept, got %s", got)
+	}
+}
+
+func TestReportedBoolAndInt64State(t *testing.T) {
+	enabled, port := true, int64(3000)
+	if got := reportedBoolState(types.BoolNull(), &enabled, true); !got.IsNull() {
+		t.Fatalf("expected default bool to be null, got %s", got)
+	}
+	if got := reportedBoolState(types.BoolNull(), &enabled, false); !got.ValueBool() {
+		t.Fatalf("expected non-default bool to be reported, got %s", got)
+	}
+	if got := reportedInt64State(types.Int64Null(), &port, 3000); !got.IsNull() {
+		t.Fatalf("expected default int64 to be null, got %s", got)
+	}
+	if got := reportedInt64State(types.Int64Value(8080), nil, 3000); !got.IsNull() {
+		t.Fatalf("expected missing int64 to be null, got %s", got)
+	}
+}
+
+func TestReportedStringMapState(t *testing.T) {
+	ctx := context.Background()
+	empty := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	got, diags := reportedStringMapState(ctx, empty, nil)
+	if diags.HasError() || got.IsNull() {
+		t.Fatalf("expected configured empty map to be kept, got %s", got)
+	}
+	got, diags = reportedStringMapState(ctx, types.MapNull(types.StringType), nil)
+	if diags.HasError() || !got.IsNull() {
+		t.Fatalf("expected unconfigured empty map to be null, got %s", got)
+	}
+	got, diags = reportedStringMapState(ctx, types.MapNull(types.StringType), map[string]string{"traefik.enable": "true"})
+	if diags.HasError() || len(got.Elements()) != 1 {
+		t.Fatalf("expected labels set in Dokploy to be reported, got %s", got)
+	}
+}
+
+func TestRefreshApplicationGithubState(t *testing.T) {
+	state := ApplicationResourceModel{
+		GithubRepository: types.StringNull(),
+		GithubBranch:     types.StringNull(),
+		GithubBuildPath:  types.StringNull(),
+		TriggerType:      types.StringValue("push"),
+		GithubWatchPaths: types.ListNull(types.StringType),
+	}
+	app := client.Application{
+		SourceType:      "github",
+		RepositoryURL:   "api",
+		Branch:          "main",
+		GithubID:        "gh-1",
+		GithubOwner:     "acme",
+		GithubBuildPath: "/",
+		TriggerType:     "push",
+	}
+
+	if diags := refreshApplicationGithubState(context.Background(), &state, &app); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.GithubRepository.ValueString() != "api" || state.GithubBranch.ValueString() != "main" {
+		t.Fatalf("expected repository and branch to fall back to the shared columns, got %s %s", state.GithubRepository, state.GithubBranch)
+	}
+	if state.GithubID.ValueString() != "gh-1" || state.GithubOwner.ValueString() != "acme" {
+		t.Fatalf("unexpected github id or owner: %s %s", state.GithubID, state.GithubOwner)
+	}
+	if !state.GithubBuildPath.IsNull() || state.TriggerType.ValueString() != "push" || !state.GithubWatchPaths.IsNull() {
+		t.Fatalf("unexpected defaults: %s %s %s", state.GithubBuildPath, state.TriggerType, state.GithubWatchPaths)
+	}
+}
+
+func TestGithubProviderConfigFromPlan_ClearsUnsetSettings(t *testing.T) {
+	plan := ApplicationResourceModel{
+		GithubID:         types.StringValue("gh-1"),
+		GithubRepository: types.StringValue("api"),
+		GithubBranch:     types.StringValue("main"),
+		GithubOwner:      types.StringNull(),
+		GithubBuildPath:  types.StringNull(),
+		GithubWatchPaths: types.ListNull(types.StringType),
+		TriggerType:      types.StringNull(),
+		EnableSubmodules: types.BoolNull(),
+	}
+
+	config, diags := githubProviderConfigFromPlan(context.Background(), plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if config["repository"] != "api" || config["branch"] != "main" || config["githubId"] != "gh-1" {
+		t.Fatalf("unexpected github settings: %#v", config)
+	}
+	if owner, ok := config["owner"]; !ok || owner != nil {
+		t.Fatalf("expected owner to be cleared, got %#v", config)
+	}
+	if config["buildPath"] != "/" || config["triggerType"] != "push" || config["enableSubmodules"] != false {
+		t.Fatalf("expected defaults for unset settings, got %#v", config)
+	}
+	if watchPaths, ok := config["watchPaths"].([]string); !ok || len(watchPaths) != 0 {
+		t.Fatalf("expected watch paths to be cleared, got %#v", config["watchPaths"])
+	}
+}
+
+func TestDiffApplicationPorts(t *testing.T) {
+	actual := []client.Port{
+		{ID: "p-80", PublishedPort: 80, TargetPort: 8080, Protocol: "tcp", PublishMode: "ingress"},
+		{ID: "p-443", PublishedPort: 443, TargetPort: 8443, Protocol: "tcp", PublishMode: "ingress"},
+		{ID: "p-9000", PublishedPort: 9000, TargetPort: 9000, Protocol: "tcp", PublishMode: "ingress"},
+	}
+	previous := []client.Port{
+		{PublishedPort: 80, TargetPort: 8080, Protocol: "tcp", PublishMode: "ingress"},
+		{PublishedPort: 443, TargetPort: 8443, Protocol: "tcp", PublishMode: "ingress"},
+	}
+	planned := []client.Port{
+		{PublishedPort: 80, TargetPort: 3000, Protocol: "tcp", PublishMode: "ingress"},
+		{PublishedPort: 53, TargetPort: 53, Protocol: "udp", PublishMode: "host"},
+	}
+
+	changes := diffApplicationPorts(planned, previous, actual)
+
+	if len(changes.Create) != 1 || changes.Create[0].PublishedPort != 53 {
+		t.Fatalf("unexpected creates: %#v", changes.Create)
+	}
+	if len(changes.Update) != 1 || changes.Update[0].ID != "p-80" || changes.Update[0].TargetPort != 3000 {
+		t.Fatalf("unexpected updates: %#v", changes.Update)
+	}
+	// Port 9000 was never managed inline (e.g. a dokploy_port resource) and must be kept.
+	if len(changes.Delete) != 1 || changes.Delete[0].ID != "p-443" {
+		t.Fatalf("unexpected deletes: %#v", changes.Delete)
+	}
+}
+
+func TestDiffApplicationPorts_DoesNotAdoptUnmanagedPorts(t *testing.T) {
+	actual := []client.Port{
+		{ID: "p-80", PublishedPort: 80, TargetPort: 8080, Protocol: "tcp", PublishMode: "ingress"},
+		// Owned by a dokploy_port resource.
+		{ID: "p-9000", PublishedPort: 9000, TargetPort: 9000, Protocol: "tcp", PublishMode: "ingress"},
+	}
+	previous := []client.Port{
+		{PublishedPort: 80, TargetPort: 8080, Protocol: "tcp", PublishMode: "ingress"},
+	}
+	planned := []client.Port{
+		{PublishedPort: 9000, TargetPort: 9100, Protocol: "tcp", PublishMode: "ingress"},
+	}
+
+	changes := diffApplicationPorts(planned, previous, actual)
+
+	if len(changes.Create) != 1 || changes.Create[0].PublishedPort != 9000 || changes.Create[0].ID != "" {
+		t.Fatalf("expected the planned port to be created, got %#v", changes.Create)
+	}
+	if len(changes.Update) != 0 {
+		t.Fatalf("unmanaged port must not be updated: %#v", changes.Update)
+	}
+	if len(changes.Delete) != 1 || changes.Delete[0].ID != "p-80" {
+		t.Fatalf("unexpected deletes: %#v", changes.Delete)
+	}
+}
+
+func TestDiffApplicationMounts(t *testing.T) {
+	actual := []client.Mount{
+		{ID: "m-data", MountType: "volume", MountPath: "/data", VolumeName: "app-abc_data"},
+		{ID: "m-cache", MountType: "volume", MountPath: "/cache", VolumeName: "cache"},
+		{ID: "m-logs", MountType: "volume", MountPath: "/logs", VolumeName: "logs"},
+	}
+	previous := []client.Mount{
+		{MountType: "volume", MountPath: "/data", VolumeName: "data"},
+		{MountType: "volume", MountPath: "/cache", VolumeName: "cache"},
+		{MountType: "volume", MountPath: "/logs", VolumeName: "logs"},
+	}
+	planned := []client.Mount{
+		{MountType: "volume", MountPath: "/data/", VolumeName: "data"},
+		{MountType: "volume", MountPath: "/cache", VolumeName: "cache-v2"},
+		{MountType: "volume", MountPath: "/uploads", VolumeName: "uploads"},
+	}
+
+	changes := diffApplicationMounts(planned, previous, actual)
+
+	created := map[string]bool{}
+	for _, mount := range changes.Create {
+		created[mount.MountPath] = true
+	}
+	if len(changes.Create) != 2 || !created["/cache"] || !created["/uploads"] {
+		t.Fatalf("unexpected creates: %#v", changes.Create)
+	}
+	deleted := map[string]bool{}
+	for _, mount := range changes.Delete {
+		deleted[mount.ID] = true
+	}
+	if len(changes.Delete) != 2 || !deleted["m-cache"] || !deleted["m-logs"] {
+		t.Fatalf("unexpected deletes: %#v", changes.Delete)
+	}
+}
+
+func TestManagedApplicationMountsState_IgnoresUnmanagedMounts(t *testing.T) {
+	ctx := context.Background()
+	current, diags := applicationMountsToList([]client.Mount{
+		{MountType: "volume", MountPath: "/data", VolumeName: "data"},
+		{MountType: "volume", MountPath: "/cache", VolumeName: "cache"},
+		{MountType: "volume", MountPath: "/logs", VolumeName: "logs"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	actual := []client.Mount{
+		{ID: "m-data", MountType: "volume", MountPath: "/data", VolumeName: "app-abc_data"},
+		{ID: "m-cache", MountType: "volume", MountPath: "/cache", VolumeName: "cache-v2"},
+		// Added by a dokploy_mount resource; must survive the next apply.
+		{ID: "m-extra", MountType: "volume", MountPath: "/extra", VolumeName: "extra"},
+	}
+
+	refreshed, diags := managedApplicationMountsState(ctx, current, actual)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	mounts, diags := applicationMountsFromList(ctx, refreshed)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := []client.Mount{
+		{MountType: "volume", MountPath: "/data", VolumeName: "data"},
+		{MountType: "volume", MountPath: "/cache", VolumeName: "cache-v2"},
+	}
+	if len(mounts) != len(expected) {
+		t.Fatalf("unexpected mounts: %#v", mounts)
+	}
+	for i := range expected {
+		if mounts[i] != expected[i] {
+			t.Fatalf("unexpected mount %d: got %#v want %#v", i, mounts[i], expected[i])
+		}
+	}
+
+	changes := diffApplicationMounts(mounts, mounts, actual)
+	for _, mount := range changes.Delete {
+		if mount.ID == "m-extra" {
+			t.Fatalf("unmanaged mount scheduled for deletion: %#v", changes.Delete)
+		}
+	}
+
+	if unset, _ := managedApplicationMountsState(ctx, types.ListNull(applicationMountObjectType), actual); !unset.IsNull() {
+		t.Fatalf("expected unset mounts to stay null, got %v", unset)
+	}
+}
+
+func TestRegistryPasswordFromPlan(t *testing.T) {
+	plan := ApplicationResourceModel{Password: types.StringValue("legacy")}
+	if got := registryPasswordFromPlan(plan, types.StringValue("secret")); got.ValueString() != "secret" {
+		t.Fatalf("expected password_wo to win, got %s", got)
+	}
+	if got := registryPasswordFromPlan(plan, types.StringNull()); got.ValueString() != "legacy" {
+		t.Fatalf("expected the deprecated password as fallback, got %s", got)
+	}
+}
+
+func TestDockerProviderChanged(t *testing.T) {
+	state := ApplicationResourceModel{
+		SourceType:      types.StringValue("docker"),
+		DockerImage:     types.StringValue("ghcr.io/acme/api:1.0.0"),
+		RegistryURL:     types.StringValue("ghcr.io"),
+		Username:        types.StringValue("acme"),
+		Password:        types.StringNull(),
+		PasswordVersion: types.Int64Value(1),
+	}
+
+	if dockerProviderChanged(state, state) {
+		t.Fatal("expected unchanged docker settings not to be saved again")
+	}
+	plan := state
+	plan.DockerImage = types.StringValue("ghcr.io/acme/api:1.1.0")
+	if !dockerProviderChanged(plan, state) {
+		t.Fatal("expected a new image to be saved")
+	}
+	plan = state
+	plan.PasswordVersion = types.Int64Value(2)
+	if !dockerProviderChanged(plan, state) {
+		t.Fatal("expected a new password_version to be saved")
+	}
+	plan = state
+	state.SourceType = types.StringValue("github")
+	if !dockerProviderChanged(plan, state) {
+		t.Fatal("expected switching to a docker source to be saved")
+	}
+}
+
+func TestManagedApplicationPortsState_IgnoresUnmanagedPorts(t *testing.T) {
+	ctx := context.Background()
+	current := types.ListValueMust(applicationPortObjectType, []attr.Value{
+		types.ObjectValueMust(applicationPortAttrTypes, map[string]attr.Value{
+			"published_port": types.Int64Value(80),
+			"target_port":    types.Int64Value(8080),
+			"protocol":       types.StringNull(),
+			"publish_mode":   types.StringNull(),
+		}),
+		types.ObjectValueMust(applicationPortAttrTypes, map[string]attr.Value{
+			"published_port": types.Int64Value(53),
+			"target_port":    types.Int64Value(53),
+			"protocol":       types.StringValue("UDP"),
+			"publish_mode":   types.StringValue("host"),
+		}),
+		types.ObjectValueMust(applicationPortAttrTypes, map[string]attr.Value{
+			"published_port": types.Int64Value(443),
+			"target_port":    types.Int64Value(8443),
+			"protocol":       types.StringNull(),
+			"publish_mode":   types.StringNull(),
+		}),
+	})
+	actual := []client.Port{
+		{ID: "p-80", PublishedPort: 80, TargetPort: 3000, Protocol: "tcp", PublishMode: "ingress"},
+		{ID: "p-53", PublishedPort: 53, TargetPort: 53, Protocol: "udp", PublishMode: "ingress"},
+		// Added by a dokploy_port resource; must survive the next apply.
+		{ID: "p-9000", PublishedPort: 9000, TargetPort: 9000, Protocol: "tcp", PublishMode: "ingress"},
+	}
+
+	refreshed, diags := managedApplicationPortsState(ctx, current, actual)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var ports []ApplicationPortResourceModel
+	diags = refreshed.ElementsAs(ctx, &ports, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(ports) != 2 {
+		t.Fatalf("expected only managed ports that still exist, got %#v", ports)
+	}
+	if ports[0].TargetPort.ValueInt64() != 3000 || !ports[0].Protocol.IsNull() || !ports[0].PublishMode.IsNull() {
+		t.Fatalf("expected target port drift with unset defaults kept null, got %#v", ports[0])
+	}
+	if ports[1].Protocol.ValueString() != "UDP" || ports[1].PublishMode.ValueString() != "ingress" {
+		t.Fatalf("expected configured protocol spelling and publish mode drift, got %#v", ports[1])
+	}
+
+	previous, diags := applicationPortsFromList(ctx, refreshed)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	for _, port := range diffApplicationPorts(nil, previous, actual).Delete {
+		if port.ID == "p-9000" {
+			t.Fatal("unmanaged port scheduled for deletion")
+		}
+	}
+}
+
+func TestApplicationResourceCreate_KeepsApplicationInStateWhenSetupFails(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]tftypes.Value
+		failing    string
+	}{
+		{
+			name: "build env",
+			attributes: map[string]tftypes.Value{
+				"build_args": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"NODE_ENV": tftypes.NewValue(tftypes.String, "production"),
+				}),
+			},
+			failing: "/application.one",
+		},
+		{
+			name: "docker provider",
+			attributes: map[string]tftypes.Value{
+				"source_type":  tftypes.NewValue(tftypes.String, "docker"),
+				"docker_image": tftypes.NewValue(tftypes.String, "nginx:1.27"),
+			},
+			failing: "/application.saveDockerProvider",
+		},
+		{
+			name: "git provider",
+			attributes: map[string]tftypes.Value{
+				"source_type":      tftypes.NewValue(tftypes.String, "gitea"),
+				"gitea_id":         tftypes.NewValue(tftypes.String, "gitea-1"),
+				"gitea_owner":      tftypes.NewValue(tftypes.String, "acme"),
+				"gitea_repository": tftypes.NewValue(tftypes.String, "web"),
+				"gitea_branch":     tftypes.NewValue(tftypes.String, "main"),
+			},
+			failing: "/application.saveGiteaProvider",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case test.failing:
+					http.Error(w, "boom", http.StatusBadRequest)
+				case "/application.create":
+					_, _ = w.Write([]byte(`{"applicationId":"app-1","name":"web","environmentId":"env-1"}`))
+				case "/application.update":
+					_, _ = w.Write([]byte(`{"applicationId":"app-1","name":"web","environmentId":"env-1"}`))
+				default:
+					_, _ = w.Write([]byte(`true`))
+				}
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			r := NewApplicationResource().(*ApplicationResource)
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: client.NewDokployClient(server.URL, "test-key")}, &resource.ConfigureResponse{})
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["name"] = tftypes.NewValue(tftypes.String, "web")
+			values["environment_id"] = tftypes.NewValue(tftypes.String, "env-1")
+			for name, value := range test.attributes {
+				values[name] = value
+			}
+			raw := tftypes.NewValue(objectType, values)
+
+			req := resource.CreateRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+			}
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			r.Create(ctx, req, resp)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("expected Create to fail")
+			}
+
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id.ValueString() != "app-1" {
+				t.Fatalf("expected the created application in state, got %s", id)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceUpdate_RedeploysWhenSourceUploadFails(t *testing.T) {
+	var deploys int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/application.deploy":
+			deploys++
+			_, _ = w.Write([]byte(`true`))
+		case "/application.one":
+			_, _ = w.Write([]byte(`{"applicationId":"app-1","name":"web","environmentId":"env-1","sourceType":"drop"}`))
+		case "/application.dropDeployment":
+			t.Errorf("unexpected upload of an unreadable source")
+		default:
+			_, _ = w.Write([]byte(`true`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := NewApplicationResource().(*ApplicationResource)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: client.NewDokployClient(server.URL, "test-key")}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	value := func(sourceHash, trigger string) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, "app-1")
+		values["name"] = tftypes.NewValue(tftypes.String, "web")
+		values["environment_id"] = tftypes.NewValue(tftypes.String, "env-1")
+		values["source_type"] = tftypes.NewValue(tftypes.String, "drop")
+		values["source_directory"] = tftypes.NewValue(tftypes.String, t.TempDir()+"/missing")
+		values["source_hash"] = tftypes.NewValue(tftypes.String, sourceHash)
+		values["redeploy_triggers"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"config": tftypes.NewValue(tftypes.String, trigger),
+		})
+		return tftypes.NewValue(objectType, values)
+	}
+
+	planned := value("new-hash", "v2")
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: planned},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: value("old-hash", "v1")},
+	}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: value("old-hash", "v1")}}
+	r.Update(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the failed upload to be reported")
+	}
+	if deploys != 1 {
+		t.Fatalf("expected the trigger change to redeploy once, got %d deploys", deploys)
+	}
+
+	var state ApplicationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.SourceHash.ValueString() != "old-hash" {
+		t.Fatalf("expected the previous source hash to be kept, got %s", state.SourceHash)
+	}
+	if trigger := state.RedeployTriggers.Elements()["config"].(types.String).ValueString(); trigger != "v2" {
+		t.Fatalf("expected the redeployed trigger in state, got %q", trigger)
+	}
+}
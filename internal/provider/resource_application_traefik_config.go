@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &ApplicationTraefikConfigResource{}
+var _ resource.ResourceWithImportState = &ApplicationTraefikConfigResource{}
+
+func NewApplicationTraefikConfigResource() resource.Resource {
+	return &ApplicationTraefikConfigResource{}
+}
+
+type ApplicationTraefikConfigResource struct {
+	client *client.DokployClient
+}
+
+type ApplicationTraefikConfigResourceModel struct {
+	ID                types.String    `tfsdk:"id"`
+	ApplicationID     types.String    `tfsdk:"application_id"`
+	Config            YAMLStringValue `tfsdk:"config"`
+	RedeployOnApply   types.Bool      `tfsdk:"redeploy_on_apply"`
+	WaitForDeployment types.Bool      `tfsdk:"wait_for_deployment"`
+	Timeouts          timeouts.Value  `tfsdk:"timeouts"`
+}
+
+func (r *ApplicationTraefikConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_traefik_config"
+}
+
+func (r *ApplicationTraefikConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the dynamic Traefik file Dokploy keeps for an application, e.g. to add headers or sticky sessions to its routers. Dokploy regenerates the file when the application's domains change, which shows up as drift on the next plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.StringAttribute{
+				CustomType:  YAMLStringType{},
+				Required:    true,
+				Description: "Full content of the application's Traefik file. Must be valid YAML; formatting differences such as indentation, key order and quoting are not reported as changes.",
+			},
+			"redeploy_on_apply": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If true, redeploys the application after the config is written. Traefik picks up file changes without a redeploy.",
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, waits for deployments triggered by this resource to finish and fails the apply when the deployment fails.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *ApplicationTraefikConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationTraefikConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApplicationTraefikConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+	if err := r.client.UpdateApplicationTraefikConfig(applicationID, plan.Config.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error creating application Traefik config", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(applicationID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RedeployOnApply.ValueBool() {
+		createTimeout, diags := plan.Timeouts.Create(ctx, defaultDeploymentTimeout)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() {
+			redeployTarget(ctx, r.client, "application", applicationID, plan.WaitForDeployment.ValueBool(), createTimeout, &resp.Diagnostics)
+		}
+	}
+}
+
+func (r *ApplicationTraefikConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ApplicationTraefikConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.ReadApplicationTraefikConfig(state.ApplicationID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading application Traefik config", err.Error())
+		return
+	}
+
+	state.ID = state.ApplicationID
+	state.Config = NewYAMLStringValue(config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ApplicationTraefikConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ApplicationTraefikConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+	configChanged := !plan.Config.Equal(state.Config)
+	if configChanged {
+		if err := r.client.UpdateApplicationTraefikConfig(applicationID, plan.Config.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error updating application Traefik config", err.Error())
+			return
+		}
+	}
+	plan.ID = types.StringValue(applicationID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configChanged && plan.RedeployOnApply.ValueBool() {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeploymentTimeout)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() {
+			redeployTarget(ctx, r.client, "application", applicationID, plan.WaitForDeployment.ValueBool(), updateTimeout, &resp.Diagnostics)
+		}
+	}
+}
+
+func (r *ApplicationTraefikConfigResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Intentionally no-op: the file belongs to the application, and Dokploy
+	// regenerates it from the domains on their next change.
+}
+
+func (r *ApplicationTraefikConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), req.ID)...)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func NewBitbucketProviderResource() resource.Resource {
+	return &gitProviderResource[BitbucketProviderResourceModel, client.BitbucketProvider]{spec: bitbucketProviderSpec}
+}
+
+type BitbucketProviderResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	GitProviderID      types.String `tfsdk:"git_provider_id"`
+	Name               types.String `tfsdk:"name"`
+	Username           types.String `tfsdk:"username"`
+	Email              types.String `tfsdk:"email"`
+	WorkspaceName      types.String `tfsdk:"workspace_name"`
+	AppPassword        types.String `tfsdk:"app_password"`
+	APIToken           types.String `tfsdk:"api_token"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+}
+
+var bitbucketProviderSpec = gitProviderResourceSpec[BitbucketProviderResourceModel, client.BitbucketProvider]{
+	SourceType:  "bitbucket",
+	DisplayName: "Bitbucket",
+	Attributes: map[string]schema.Attribute{
+		"username": schema.StringAttribute{
+			Required:    true,
+			Description: "Bitbucket username the credentials belong to.",
+		},
+		"email": schema.StringAttribute{
+			Optional:    true,
+			Description: "Atlassian account email. Required with api_token.",
+		},
+		"workspace_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "Workspace to list repositories from. Defaults to the user's own workspace.",
+		},
+	},
+	Secrets: []gitProviderSecret{
+		{Name: "app_password", Description: "Bitbucket app password with repository read access. Conflicts with api_token."},
+		{Name: "api_token", Description: "Atlassian API token with repository read access. Conflicts with app_password."},
+	},
+	Validate:   validateBitbucketProvider,
+	FromPlan:   bitbucketProviderFromPlan,
+	ApplyState: applyBitbucketProviderState,
+	Create:     (*client.DokployClient).CreateBitbucketProvider,
+	Get:        (*client.DokployClient).GetBitbucketProvider,
+	Update:     (*client.DokployClient).UpdateBitbucketProvider,
+}
+
+func validateBitbucketProvider(config BitbucketProviderResourceModel, diags *diag.Diagnostics) {
+	if config.AppPassword.IsNull() == config.APIToken.IsNull() {
+		diags.AddAttributeError(
+			path.Root("app_password"),
+			"Invalid Bitbucket Credentials",
+			"Exactly one of app_password and api_token must be set.",
+		)
+	}
+	if !config.APIToken.IsNull() && config.Email.IsNull() {
+		diags.AddAttributeError(
+			path.Root("email"),
+			"Missing Bitbucket Email",
+			"email is required when api_token is set.",
+		)
+	}
+}
+
+// bitbucketProviderFromPlan builds the API request. Secrets are write-only and
+// therefore taken from the configuration.
+func bitbucketProviderFromPlan(plan, config BitbucketProviderResourceModel) client.BitbucketProvider {
+	return client.BitbucketProvider{
+		ID:            plan.ID.ValueString(),
+		GitProviderID: plan.GitProviderID.ValueString(),
+		Name:          plan.Name.ValueString(),
+		Username:      plan.Username.ValueString(),
+		Email:         optionalStringFromPlan(plan.Email),
+		WorkspaceName: optionalStringFromPlan(plan.WorkspaceName),
+		AppPassword:   optionalStringFromPlan(config.AppPassword),
+		APIToken:      optionalStringFromPlan(config.APIToken),
+	}
+}
+
+func applyBitbucketProviderState(state BitbucketProviderResourceModel, provider *client.BitbucketProvider) BitbucketProviderResourceModel {
+	state.ID = types.StringValue(provider.ID)
+	state.GitProviderID = types.StringValue(provider.GitProviderID)
+	if provider.Name != "" {
+		state.Name = types.StringValue(provider.Name)
+	}
+	if provider.Username != "" {
+		state.Username = types.StringValue(provider.Username)
+	}
+	state.Email = optionalStringState(state.Email, provider.Email)
+	state.WorkspaceName = optionalStringState(state.WorkspaceName, provider.WorkspaceName)
+	return state
+}
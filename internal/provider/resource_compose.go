@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/j0bit/terraform-provider-dokploy/internal/client"
@@ -17,6 +21,8 @@ import (
 
 var _ resource.Resource = &ComposeResource{}
 var _ resource.ResourceWithImportState = &ComposeResource{}
+var _ resource.ResourceWithValidateConfig = &ComposeResource{}
+var _ resource.ResourceWithModifyPlan = &ComposeResource{}
 
 func NewComposeResource() resource.Resource {
 	return &ComposeResource{}
@@ -39,14 +45,64 @@ type ComposeResourceModel struct {
 	ComposePath            types.String `tfsdk:"compose_path"`
 	AutoDeploy             types.Bool   `tfsdk:"auto_deploy"`
 	DeployOnCreate         types.Bool   `tfsdk:"deploy_on_create"`
+	WaitForDeployment      types.Bool   `tfsdk:"wait_for_deployment"`
+	RedeployTriggers       types.Map    `tfsdk:"redeploy_triggers"`
 	DeleteVolumesOnDestroy types.Bool   `tfsdk:"delete_volumes_on_destroy"`
+	ComposeType            types.String `tfsdk:"compose_type"`
+	AppName                types.String `tfsdk:"app_name"`
+	IsolatedDeployment     types.Bool   `tfsdk:"isolated_deployment"`
+	Randomize              types.Bool   `tfsdk:"randomize"`
+	Services               types.List   `tfsdk:"services"`
+	Volumes                types.List   `tfsdk:"volumes"`
+	// GitHub Provider fields
+	GithubRepository types.String `tfsdk:"github_repository"`
+	GithubOwner      types.String `tfsdk:"github_owner"`
+	GithubBranch     types.String `tfsdk:"github_branch"`
+	GithubID         types.String `tfsdk:"github_id"`
+	GithubWatchPaths types.List   `tfsdk:"github_watch_paths"`
+	EnableSubmodules types.Bool   `tfsdk:"enable_submodules"`
+	TriggerType      types.String `tfsdk:"trigger_type"`
+	// GitLab, Bitbucket and Gitea Provider fields
+	GitlabRepository    types.String `tfsdk:"gitlab_repository"`
+	GitlabOwner         types.String `tfsdk:"gitlab_owner"`
+	GitlabBranch        types.String `tfsdk:"gitlab_branch"`
+	GitlabID            types.String `tfsdk:"gitlab_id"`
+	GitlabWatchPaths    types.List   `tfsdk:"gitlab_watch_paths"`
+	GitlabProjectID     types.Int64  `tfsdk:"gitlab_project_id"`
+	BitbucketRepository types.String `tfsdk:"bitbucket_repository"`
+	BitbucketOwner      types.String `tfsdk:"bitbucket_owner"`
+	BitbucketBranch     types.String `tfsdk:"bitbucket_branch"`
+	BitbucketID         types.String `tfsdk:"bitbucket_id"`
+	BitbucketWatchPaths types.List   `tfsdk:"bitbucket_watch_paths"`
+	GiteaRepository     types.String `tfsdk:"gitea_repository"`
+	GiteaOwner          types.String `tfsdk:"gitea_owner"`
+	GiteaBranch         types.String `tfsdk:"gitea_branch"`
+	GiteaID             types.String `tfsdk:"gitea_id"`
+	GiteaWatchPaths     types.List   `tfsdk:"gitea_watch_paths"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// gitProviderSourceFields returns the compose stack's attributes for a
+// GitHub, GitLab, Bitbucket or Gitea source.
+func (m *ComposeResourceModel) gitProviderSourceFields(provider string) gitProviderSourceFields {
+	switch provider {
+	case "github":
+		return gitProviderSourceFields{ID: &m.GithubID, Repository: &m.GithubRepository, Owner: &m.GithubOwner, Branch: &m.GithubBranch, WatchPaths: &m.GithubWatchPaths}
+	case "gitlab":
+		return gitProviderSourceFields{ID: &m.GitlabID, Repository: &m.GitlabRepository, Owner: &m.GitlabOwner, Branch: &m.GitlabBranch, WatchPaths: &m.GitlabWatchPaths, ProjectID: &m.GitlabProjectID}
+	case "bitbucket":
+		return gitProviderSourceFields{ID: &m.BitbucketID, Repository: &m.BitbucketRepository, Owner: &m.BitbucketOwner, Branch: &m.BitbucketBranch, WatchPaths: &m.BitbucketWatchPaths}
+	default:
+		return gitProviderSourceFields{ID: &m.GiteaID, Repository: &m.GiteaRepository, Owner: &m.GiteaOwner, Branch: &m.GiteaBranch, WatchPaths: &m.GiteaWatchPaths}
+	}
 }
 
 func (r *ComposeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_compose"
 }
 
-func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ComposeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -107,6 +163,15 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"deploy_on_create": schema.BoolAttribute{
 				Optional: true,
 			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, waits for deployments triggered by this resource to finish and fails the apply when the deployment fails.",
+			},
+			"redeploy_triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that trigger a redeploy when they change, for example hashes of environment variables or IDs of related domains.",
+			},
 			"delete_volumes_on_destroy": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -115,8 +180,167 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 				Description: "If true, deletes attached volumes when this compose stack is destroyed.",
 			},
+			"compose_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("docker-compose"),
+				Description: "How the stack is deployed: docker-compose (default) or stack for a Docker Swarm stack.",
+			},
+			"app_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Name of the stack on the Docker host, which prefixes its containers, networks and volumes. Defaults to name with a random suffix. Changing it deploys a new stack on the next deployment and leaves the old one in place.",
+			},
+			"isolated_deployment": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If true, the stack is deployed into its own network, so its services are not reachable from other stacks by service name.",
+			},
+			"randomize": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If true, Dokploy adds a random suffix to service and volume names so several copies of the same compose file can run side by side.",
+			},
+			"services": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Names of the services defined in the compose file, sorted. Known at plan time for inline compose_file_content. Pass it to compose_services of dokploy_domain and dokploy_volume_backup to check their service_name against the planned compose file.",
+			},
+			"volumes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Names of the top-level volumes defined in the compose file, sorted. Volumes with an explicit name are listed under both names. Pass it to compose_volumes of dokploy_volume_backup to check volume_name against the planned compose file.",
+			},
+			"github_repository": schema.StringAttribute{
+				Optional:    true,
+				Description: "GitHub repository name.",
+			},
+			"github_owner": schema.StringAttribute{
+				Optional:    true,
+				Description: "User or organization that owns the GitHub repository.",
+			},
+			"github_branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "GitHub branch to deploy.",
+			},
+			"github_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the GitHub App integration configured in Dokploy. Setting it saves the GitHub source when source_type is github.",
+			},
+			"github_watch_paths": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Paths that trigger an automatic deployment when changed by a push.",
+			},
+			"enable_submodules": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, git submodules are cloned together with the repository.",
+			},
+			"trigger_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "What triggers automatic GitHub deployments: push (default) or tag.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
+	for name, attribute := range gitProviderSchemaAttributes(false) {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (r *ComposeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ComposeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateGitProviderSource(config.SourceType, config.gitProviderSourceFields, &resp.Diagnostics)
+	if content := config.ComposeFileContent; !content.IsNull() && !content.IsUnknown() {
+		if _, err := parseComposeFile(content.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("compose_file_content"),
+				"Invalid Compose File",
+				fmt.Sprintf("compose_file_content is not valid YAML: %s", err),
+			)
+		}
+	}
+	if !config.GithubID.IsNull() && (config.SourceType.IsNull() || config.SourceType.ValueString() == "github") {
+		requireGitProviderAttributes("github", config.gitProviderSourceFields("github"), &resp.Diagnostics)
+	}
+	if composeType := config.ComposeType.ValueString(); composeType != "" && composeType != "docker-compose" && composeType != "stack" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compose_type"),
+			"Invalid Compose Type",
+			"compose_type must be docker-compose or stack.",
+		)
+	}
+	if triggerType := config.TriggerType.ValueString(); triggerType != "" && triggerType != "push" && triggerType != "tag" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("trigger_type"),
+			"Invalid Trigger Type",
+			"trigger_type must be push or tag.",
+		)
+	}
+}
+
+// ModifyPlan derives services and volumes from the planned compose file and
+// rejects a configured compose file that cannot be parsed.
+func (r *ComposeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured, content types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("compose_file_content"), &configured)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("compose_file_content"), &content)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configured.IsNull() && !configured.IsUnknown() && strings.TrimSpace(configured.ValueString()) != "" {
+		if _, err := parseComposeFile(configured.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("compose_file_content"),
+				"Invalid Compose File",
+				fmt.Sprintf("The services and volumes of the compose file could not be read: %s", err),
+			)
+			return
+		}
+	}
+	services, volumes, diags := composeFileState(ctx, content)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("services"), services)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("volumes"), volumes)...)
+}
+
+// composeGitSourceFromPlan returns the repository settings to save for a git
+// provider source, or nil when the compose stack is not deployed from one.
+// GitHub sources are only saved once github_id is set, because github is also
+// the default source type of stacks without a repository.
+func composeGitSourceFromPlan(ctx context.Context, plan ComposeResourceModel) (*client.GitProviderSource, diag.Diagnostics) {
+	provider := plan.SourceType.ValueString()
+	if provider == "github" {
+		if optionalStringFromPlan(plan.GithubID) == "" {
+			return nil, nil
+		}
+	} else if !client.IsGitProviderSourceType(provider) {
+		return nil, nil
+	}
+
+	source, diags := gitProviderSourceFromPlan(ctx, provider, plan.gitProviderSourceFields(provider), plan.EnableSubmodules)
+	if provider == "github" {
+		source.TriggerType = optionalStringFromPlan(plan.TriggerType)
+	}
+	return &source, diags
 }
 
 func (r *ComposeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -148,6 +372,8 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 			plan.SourceType = types.StringValue("git")
 		} else if !plan.ComposeFileContent.IsNull() && !plan.ComposeFileContent.IsUnknown() && plan.ComposeFileContent.ValueString() != "" {
 			plan.SourceType = types.StringValue("raw")
+		} else if provider := inferGitProviderSourceType(plan.gitProviderSourceFields); provider != "" {
+			plan.SourceType = types.StringValue(provider)
 		} else {
 			plan.SourceType = types.StringValue("github")
 		}
@@ -157,15 +383,19 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	comp := client.Compose{
-		Name:              plan.Name.ValueString(),
-		EnvironmentID:     plan.EnvironmentID.ValueString(),
-		ComposeFile:       plan.ComposeFileContent.ValueString(),
-		SourceType:        plan.SourceType.ValueString(),
-		CustomGitUrl:      plan.CustomGitUrl.ValueString(),
-		CustomGitBranch:   plan.CustomGitBranch.ValueString(),
-		CustomGitSSHKeyId: plan.CustomGitSSHKeyID.ValueString(),
-		ComposePath:       plan.ComposePath.ValueString(),
-		AutoDeploy:        plan.AutoDeploy.ValueBool(),
+		Name:               plan.Name.ValueString(),
+		EnvironmentID:      plan.EnvironmentID.ValueString(),
+		ComposeFile:        plan.ComposeFileContent.ValueString(),
+		SourceType:         plan.SourceType.ValueString(),
+		CustomGitUrl:       plan.CustomGitUrl.ValueString(),
+		CustomGitBranch:    plan.CustomGitBranch.ValueString(),
+		CustomGitSSHKeyId:  plan.CustomGitSSHKeyID.ValueString(),
+		ComposePath:        plan.ComposePath.ValueString(),
+		AutoDeploy:         plan.AutoDeploy.ValueBool(),
+		ComposeType:        plan.ComposeType.ValueString(),
+		AppName:            optionalStringFromPlan(plan.AppName),
+		IsolatedDeployment: plan.IsolatedDeployment.ValueBool(),
+		Randomize:          plan.Randomize.ValueBool(),
 	}
 
 	createdComp, err := r.client.CreateCompose(comp)
@@ -176,19 +406,46 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 
 	plan.ID = types.StringValue(createdComp.ID)
 	plan.SourceType = types.StringValue(createdComp.SourceType)
+
+	source, diags := composeGitSourceFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if source != nil {
+		if err := r.client.SaveComposeGitProvider(createdComp.ID, *source); err != nil {
+			resp.Diagnostics.AddError(
+				"Error saving compose git provider",
+				fmt.Sprintf("Compose stack %s was created but saving the %s repository settings failed: %s", createdComp.ID, source.Provider, err.Error()),
+			)
+			// Keep the compose stack in state so Terraform taints it instead
+			// of losing track of it.
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
 	plan.ComposePath = types.StringValue(createdComp.ComposePath)
 	plan.AutoDeploy = types.BoolValue(createdComp.AutoDeploy)
+	plan.AppName = types.StringValue(createdComp.AppName)
 	if createdComp.ComposeFile != "" {
 		plan.ComposeFileContent = types.StringValue(createdComp.ComposeFile)
 	} else {
 		plan.ComposeFileContent = types.StringNull()
 	}
+	plan.Services, plan.Volumes, diags = composeFileState(ctx, plan.ComposeFileContent)
+	resp.Diagnostics.Append(diags...)
 
 	if !plan.DeployOnCreate.IsNull() && plan.DeployOnCreate.ValueBool() && !createdComp.AutoDeploy {
 		// Avoid duplicate deployments: Dokploy can already trigger deploys when autoDeploy is enabled.
 		err := r.client.DeployCompose(createdComp.ID)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Compose stack created but deployment failed to trigger: %s", err.Error()))
+		} else if plan.WaitForDeployment.ValueBool() {
+			createTimeout, diags := plan.Timeouts.Create(ctx, defaultDeploymentTimeout)
+			resp.Diagnostics.Append(diags...)
+			if !diags.HasError() {
+				waitForDeployment(ctx, r.client, "compose", createdComp.ID, "", createTimeout, &resp.Diagnostics)
+			}
 		}
 	}
 
@@ -223,6 +480,24 @@ func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.CustomGitSSHKeyID = types.StringValue(comp.CustomGitSSHKeyId)
 	state.ComposePath = types.StringValue(comp.ComposePath)
 	state.AutoDeploy = types.BoolValue(comp.AutoDeploy)
+	state.AppName = types.StringValue(comp.AppName)
+	if comp.ComposeType != "" {
+		state.ComposeType = types.StringValue(comp.ComposeType)
+	}
+	state.IsolatedDeployment = types.BoolValue(comp.IsolatedDeployment)
+	state.Randomize = types.BoolValue(comp.Randomize)
+	state.Services, state.Volumes, diags = composeFileState(ctx, state.ComposeFileContent)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(refreshGitProviderState(ctx, state.gitProviderSourceFields("github"), comp.GitProviderSource("github"))...)
+	for _, provider := range gitProviders {
+		resp.Diagnostics.Append(refreshGitProviderState(ctx, state.gitProviderSourceFields(provider.SourceType), comp.GitProviderSource(provider.SourceType))...)
+	}
+	if !state.EnableSubmodules.IsNull() {
+		state.EnableSubmodules = types.BoolValue(comp.EnableSubmodules)
+	}
+	if !state.TriggerType.IsNull() {
+		state.TriggerType = optionalStringState(types.StringNull(), comp.TriggerType)
+	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -239,17 +514,28 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 		plan.DeleteVolumesOnDestroy = types.BoolValue(false)
 	}
 
+	var state ComposeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	comp := client.Compose{
-		ID:                plan.ID.ValueString(),
-		Name:              plan.Name.ValueString(),
-		EnvironmentID:     plan.EnvironmentID.ValueString(),
-		ComposeFile:       plan.ComposeFileContent.ValueString(),
-		SourceType:        plan.SourceType.ValueString(),
-		CustomGitUrl:      plan.CustomGitUrl.ValueString(),
-		CustomGitBranch:   plan.CustomGitBranch.ValueString(),
-		CustomGitSSHKeyId: plan.CustomGitSSHKeyID.ValueString(),
-		ComposePath:       plan.ComposePath.ValueString(),
-		AutoDeploy:        plan.AutoDeploy.ValueBool(),
+		ID:                 plan.ID.ValueString(),
+		Name:               plan.Name.ValueString(),
+		EnvironmentID:      plan.EnvironmentID.ValueString(),
+		ComposeFile:        plan.ComposeFileContent.ValueString(),
+		SourceType:         plan.SourceType.ValueString(),
+		CustomGitUrl:       plan.CustomGitUrl.ValueString(),
+		CustomGitBranch:    plan.CustomGitBranch.ValueString(),
+		CustomGitSSHKeyId:  plan.CustomGitSSHKeyID.ValueString(),
+		ComposePath:        plan.ComposePath.ValueString(),
+		AutoDeploy:         plan.AutoDeploy.ValueBool(),
+		ComposeType:        plan.ComposeType.ValueString(),
+		AppName:            optionalStringFromPlan(plan.AppName),
+		IsolatedDeployment: plan.IsolatedDeployment.ValueBool(),
+		Randomize:          plan.Randomize.ValueBool(),
 	}
 
 	updatedComp, err := r.client.UpdateCompose(comp)
@@ -260,8 +546,34 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	plan.Name = types.StringValue(updatedComp.Name)
 	plan.ComposeFileContent = types.StringValue(updatedComp.ComposeFile)
+	plan.Services, plan.Volumes, diags = composeFileState(ctx, plan.ComposeFileContent)
+	resp.Diagnostics.Append(diags...)
 	plan.SourceType = types.StringValue(updatedComp.SourceType)
 	plan.AutoDeploy = types.BoolValue(updatedComp.AutoDeploy)
+	if updatedComp.AppName != "" {
+		plan.AppName = types.StringValue(updatedComp.AppName)
+	}
+
+	source, diags := composeGitSourceFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if source != nil {
+		if err := r.client.SaveComposeGitProvider(updatedComp.ID, *source); err != nil {
+			resp.Diagnostics.AddError("Error updating compose git provider", err.Error())
+			return
+		}
+	}
+
+	if redeployTriggersChanged(plan.RedeployTriggers, state.RedeployTriggers) {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeploymentTimeout)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || !redeployTarget(ctx, r.client, "compose", updatedComp.ID, plan.WaitForDeployment.ValueBool(), updateTimeout, &resp.Diagnostics) {
+			// Keep the previous triggers so the next apply retries the redeploy.
+			plan.RedeployTriggers = state.RedeployTriggers
+		}
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
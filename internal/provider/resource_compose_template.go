@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &ComposeTemplateResource{}
+var _ resource.ResourceWithImportState = &ComposeTemplateResource{}
+
+func NewComposeTemplateResource() resource.Resource {
+	return &ComposeTemplateResource{}
+}
+
+type ComposeTemplateResource struct {
+	client *client.DokployClient
+}
+
+type ComposeTemplateResourceModel struct {
+	ID                     types.String   `tfsdk:"id"`
+	EnvironmentID          types.String   `tfsdk:"environment_id"`
+	TemplateID             types.String   `tfsdk:"template_id"`
+	ServerID               types.String   `tfsdk:"server_id"`
+	BaseURL                types.String   `tfsdk:"base_url"`
+	Name                   types.String   `tfsdk:"name"`
+	AppName                types.String   `tfsdk:"app_name"`
+	ComposeFileContent     types.String   `tfsdk:"compose_file_content"`
+	Env                    types.Map      `tfsdk:"env"`
+	Domains                types.List     `tfsdk:"domains"`
+	DeployOnCreate         types.Bool     `tfsdk:"deploy_on_create"`
+	WaitForDeployment      types.Bool     `tfsdk:"wait_for_deployment"`
+	DeleteVolumesOnDestroy types.Bool     `tfsdk:"delete_volumes_on_destroy"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+var composeTemplateDomainAttrTypes = map[string]attr.Type{
+	"id":               types.StringType,
+	"host":             types.StringType,
+	"path":             types.StringType,
+	"port":             types.Int64Type,
+	"https":            types.BoolType,
+	"certificate_type": types.StringType,
+	"service_name":     types.StringType,
+}
+
+var composeTemplateDomainObjectType = types.ObjectType{AttrTypes: composeTemplateDomainAttrTypes}
+
+func (r *ComposeTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_template"
+}
+
+func (r *ComposeTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a compose stack from one of Dokploy's one-click templates. Dokploy generates the compose file, env and domains; manage later changes with dokploy_environment_variables and dokploy_domain, or import the stack into dokploy_compose.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the created compose stack.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Template to instantiate, e.g. plausible. See the dokploy_compose_templates data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Remote server to deploy the stack to. Defaults to the Dokploy host.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an alternative template catalogue. Defaults to Dokploy's catalogue.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the stack on the Docker host, generated from the project name and template ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compose_file_content": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"env": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Environment variables of the stack, including the secrets the template generated.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domains": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Domains of the stack, sorted by host. Import them into dokploy_domain to manage them.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"host": schema.StringAttribute{
+							Computed: true,
+						},
+						"path": schema.StringAttribute{
+							Computed: true,
+						},
+						"port": schema.Int64Attribute{
+							Computed: true,
+						},
+						"https": schema.BoolAttribute{
+							Computed: true,
+						},
+						"certificate_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"deploy_on_create": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, deploys the stack after creating it. Dokploy only creates it otherwise.",
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, waits for deployments triggered by this resource to finish and fails the apply when the deployment fails.",
+			},
+			"delete_volumes_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If true, deletes attached volumes when this compose stack is destroyed.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ComposeTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// applyComposeTemplateState copies the generated settings of comp into state.
+func applyComposeTemplateState(ctx context.Context, state *ComposeTemplateResourceModel, comp *client.Compose) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.ID = types.StringValue(comp.ID)
+	state.Name = types.StringValue(comp.Name)
+	state.AppName = types.StringValue(comp.AppName)
+	state.ComposeFileContent = types.StringValue(comp.ComposeFile)
+
+	env, d := types.MapValueFrom(ctx, types.StringType, client.ParseEnv(comp.Env))
+	diags.Append(d...)
+	state.Env = env
+
+	domains := append([]client.Domain(nil), comp.Domains...)
+	sort.SliceStable(domains, func(i, j int) bool { return domains[i].Host < domains[j].Host })
+	items := make([]attr.Value, 0, len(domains))
+	for _, domain := range domains {
+		items = append(items, types.ObjectValueMust(composeTemplateDomainAttrTypes, map[string]attr.Value{
+			"id":               types.StringValue(domain.ID),
+			"host":             types.StringValue(domain.Host),
+			"path":             types.StringValue(domain.Path),
+			"port":             types.Int64Value(domain.Port),
+			"https":            types.BoolValue(domain.HTTPS),
+			"certificate_type": types.StringValue(domain.CertificateType),
+			"service_name":     types.StringValue(domain.ServiceName),
+		}))
+	}
+	state.Domains, d = types.ListValue(composeTemplateDomainObjectType, items)
+	diags.Append(d...)
+	return diags
+}
+
+// applyImportedComposeTemplateState fills the settings an import does not
+// provide. template_id is taken from the stack name, which Dokploy sets to
+// the template ID.
+func applyImportedComposeTemplateState(state *ComposeTemplateResourceModel, comp *client.Compose) {
+	if !state.EnvironmentID.IsNull() {
+		return
+	}
+	state.EnvironmentID = types.StringValue(comp.EnvironmentID)
+	state.TemplateID = types.StringValue(comp.Name)
+	if comp.ServerID != "" {
+		state.ServerID = types.StringValue(comp.ServerID)
+	}
+	state.DeleteVolumesOnDestroy = types.BoolValue(false)
+}
+
+func (r *ComposeTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ComposeTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comp, err := r.client.DeployComposeTemplate(client.ComposeTemplateDeployment{
+		EnvironmentID: plan.EnvironmentID.ValueString(),
+		TemplateID:    plan.TemplateID.ValueString(),
+		ServerID:      optionalStringFromPlan(plan.ServerID),
+		BaseURL:       optionalStringFromPlan(plan.BaseURL),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating compose from template", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(applyComposeTemplateState(ctx, &plan, comp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DeployOnCreate.ValueBool() {
+		err := r.client.DeployCompose(comp.ID)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Deployment Trigger Failed", fmt.Sprintf("Compose stack created but deployment failed to trigger: %s", err.Error()))
+		} else if plan.WaitForDeployment.ValueBool() {
+			createTimeout, diags := plan.Timeouts.Create(ctx, defaultDeploymentTimeout)
+			resp.Diagnostics.Append(diags...)
+			if !diags.HasError() {
+				waitForDeployment(ctx, r.client, "compose", comp.ID, "", createTimeout, &resp.Diagnostics)
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ComposeTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ComposeTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comp, err := r.client.GetCompose(state.ID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading compose", err.Error())
+		return
+	}
+
+	applyImportedComposeTemplateState(&state, comp)
+	resp.Diagnostics.Append(applyComposeTemplateState(ctx, &state, comp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes the provider-side settings; everything sent to Dokploy
+// requires replacement.
+func (r *ComposeTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ComposeTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ComposeTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ComposeTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCompose(state.ID.ValueString(), state.DeleteVolumesOnDestroy.ValueBool())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting compose", err.Error())
+		return
+	}
+}
+
+// ImportState imports a compose stack created from a template by its ID.
+func (r *ComposeTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestApplyComposeTemplateState(t *testing.T) {
+	comp := &client.Compose{
+		ID:          "comp-1",
+		Name:        "plausible",
+		AppName:     "prod-plausible-x1y2z3",
+		ComposeFile: "services:\n  plausible:\n    image: plausible\n",
+		Env:         "BASE_URL=http://stats.example.com\nSECRET_KEY_BASE=abc",
+		Domains: []client.Domain{
+			{ID: "dom-2", Host: "stats.example.com", Port: 8000, ServiceName: "plausible", CertificateType: "none"},
+			{ID: "dom-1", Host: "admin.example.com", Port: 9000, ServiceName: "plausible", CertificateType: "none"},
+		},
+	}
+
+	var state ComposeTemplateResourceModel
+	diags := applyComposeTemplateState(context.Background(), &state, comp)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.ID.ValueString() != "comp-1" || state.AppName.ValueString() != "prod-plausible-x1y2z3" {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+	if got := state.Env.Elements()["SECRET_KEY_BASE"].(types.String).ValueString(); got != "abc" {
+		t.Fatalf("unexpected env: %v", state.Env)
+	}
+	domains := state.Domains.Elements()
+	if len(domains) != 2 {
+		t.Fatalf("unexpected domains: %v", state.Domains)
+	}
+	first := domains[0].(types.Object).Attributes()
+	if first["host"].(types.String).ValueString() != "admin.example.com" || first["port"].(types.Int64).ValueInt64() != 9000 {
+		t.Fatalf("expected domains sorted by host, got %v", first)
+	}
+}
+
+func TestApplyImportedComposeTemplateState(t *testing.T) {
+	comp := &client.Compose{ID: "comp-1", Name: "plausible", EnvironmentID: "env-1", ServerID: "srv-1"}
+
+	state := ComposeTemplateResourceModel{
+		EnvironmentID:          types.StringNull(),
+		TemplateID:             types.StringNull(),
+		ServerID:               types.StringNull(),
+		DeleteVolumesOnDestroy: types.BoolNull(),
+	}
+	applyImportedComposeTemplateState(&state, comp)
+	if state.EnvironmentID.ValueString() != "env-1" || state.TemplateID.ValueString() != "plausible" || state.ServerID.ValueString() != "srv-1" {
+		t.Fatalf("unexpected imported state: %+v", state)
+	}
+	if state.DeleteVolumesOnDestroy.IsNull() || state.DeleteVolumesOnDestroy.ValueBool() {
+		t.Fatalf("expected delete_volumes_on_destroy to default to false, got %s", state.DeleteVolumesOnDestroy)
+	}
+
+	// Resources created by Terraform keep their configuration.
+	state = ComposeTemplateResourceModel{
+		EnvironmentID: types.StringValue("env-1"),
+		TemplateID:    types.StringValue("plausible"),
+		ServerID:      types.StringNull(),
+	}
+	applyImportedComposeTemplateState(&state, comp)
+	if !state.ServerID.IsNull() {
+		t.Fatalf("expected server_id to stay unset, got %s", state.ServerID)
+	}
+}
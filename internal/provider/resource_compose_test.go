@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func TestComposeResourceModifyPlan_RejectsOnlyConfiguredInvalidComposeFile(t *testing.T) {
+	ctx := context.Background()
+	r := NewComposeResource().(*ComposeResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	value := func(content interface{}) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["compose_file_content"] = tftypes.NewValue(tftypes.String, content)
+		return tftypes.NewValue(objectType, values)
+	}
+	const invalid = "services: ["
+
+	tests := map[string]struct {
+		configured  interface{}
+		planned     interface{}
+		wantError   bool
+		wantWarning bool
+	}{
+		"configured invalid file": {configured: invalid, planned: invalid, wantError: true},
+		// The file Dokploy holds, e.g. one pulled from a repository.
+		"remote invalid file":   {configured: nil, planned: invalid, wantWarning: true},
+		"configured valid file": {configured: testComposeFile, planned: testComposeFile},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(test.planned)}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value(test.configured)},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != test.wantWarning {
+				t.Fatalf("unexpected warnings: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestComposeResourceCreate_KeepsComposeInStateWhenSavingGitProviderFails(t *testing.T) {
+	updates := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compose.create":
+			_, _ = w.Write([]byte(`{"composeId":"comp-1","name":"web","sourceType":"gitea"}`))
+		case "/compose.update":
+			// The first update belongs to CreateCompose, the second saves the
+			// repository settings.
+			updates++
+			if updates > 1 {
+				http.Error(w, "boom", http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"composeId":"comp-1","name":"web","sourceType":"gitea"}`))
+		default:
+			t.Errorf("unexpected endpoint called: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := NewComposeResource().(*ComposeResource)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: client.NewDokployClient(server.URL, "test-key")}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "web")
+	values["environment_id"] = tftypes.NewValue(tftypes.String, "env-1")
+	values["source_type"] = tftypes.NewValue(tftypes.String, "gitea")
+	values["gitea_id"] = tftypes.NewValue(tftypes.String, "gitea-1")
+	values["gitea_owner"] = tftypes.NewValue(tftypes.String, "acme")
+	values["gitea_repository"] = tftypes.NewValue(tftypes.String, "web")
+	values["gitea_branch"] = tftypes.NewValue(tftypes.String, "main")
+	raw := tftypes.NewValue(objectType, values)
+
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+	}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected Create to fail")
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if id.ValueString() != "comp-1" {
+		t.Fatalf("expected the created compose stack in state, got %s", id)
+	}
+}
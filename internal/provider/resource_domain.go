@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &DomainResource{}
 var _ resource.ResourceWithImportState = &DomainResource{}
+var _ resource.ResourceWithModifyPlan = &DomainResource{}
 
 func NewDomainResource() resource.Resource {
 	return &DomainResource{}
@@ -37,6 +38,7 @@ type DomainResourceModel struct {
 	CertificateProvider types.String `tfsdk:"certificate_provider"`
 	GenerateTraefikMe   types.Bool   `tfsdk:"generate_traefik_me"`
 	RedeployOnUpdate    types.Bool   `tfsdk:"redeploy_on_update"`
+	ComposeServices     types.List   `tfsdk:"compose_services"`
 }
 
 func (r *DomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,6 +106,11 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 				Description: "If true, triggers a redeploy of the associated application or compose stack when the domain is created or updated.",
 			},
+			"compose_services": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Services of the compose stack, usually dokploy_compose.<name>.services. If set, service_name must be one of them, which checks it against the planned compose file. Otherwise service_name is checked against the compose file Dokploy currently holds and an unknown service is only a warning.",
+			},
 		},
 	}
 }
@@ -120,6 +127,51 @@ func (r *DomainResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ModifyPlan checks that service_name is a service of the compose stack.
+// With compose_services set, it is checked against the planned compose file
+// and an unknown service is an error. Otherwise it is checked against the
+// compose file Dokploy holds for compose_id, which may still change in the
+// same apply, so an unknown service is only a warning. Only new or changed
+// references are checked.
+func (r *DomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var composeID, serviceName types.String
+	var composeServices types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("compose_id"), &composeID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("service_name"), &serviceName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("compose_services"), &composeServices)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var stateComposeID, stateServiceName types.String
+		var stateComposeServices types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("compose_id"), &stateComposeID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("service_name"), &stateServiceName)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("compose_services"), &stateComposeServices)...)
+		if resp.Diagnostics.HasError() ||
+			(composeID.Equal(stateComposeID) && serviceName.Equal(stateServiceName) && composeServices.Equal(stateComposeServices)) {
+			return
+		}
+	}
+
+	if composeServices.IsUnknown() {
+		return
+	}
+	declared, ok, diags := declaredComposeNames(ctx, composeServices)
+	resp.Diagnostics.Append(diags...)
+	if ok {
+		requireComposeName(&resp.Diagnostics, "service_name", "Service", serviceName, declared, "compose_services")
+		return
+	}
+	if names := deployedComposeFile(r.client, composeID); names != nil {
+		warnUnknownComposeName(&resp.Diagnostics, "service_name", "Service", serviceName, names.Services, composeID.ValueString())
+	}
+}
+
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DomainResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -344,7 +396,11 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteDomain(state.ID.ValueString())
+	err := r.client.DeleteDomain(client.Domain{
+		ID:            state.ID.ValueString(),
+		ApplicationID: state.ApplicationID.ValueString(),
+		ComposeID:     state.ComposeID.ValueString(),
+	})
 	if err != nil {
 		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
 			return
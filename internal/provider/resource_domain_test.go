@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDomainResourceModifyPlan_ChecksComposeServices(t *testing.T) {
+	ctx := context.Background()
+	r := NewDomainResource().(*DomainResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	listType := tftypes.List{ElementType: tftypes.String}
+	value := func(serviceName string, composeServices tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		// The compose stack is created in the same apply, so the compose file
+		// Dokploy holds cannot be checked.
+		values["compose_id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		values["service_name"] = tftypes.NewValue(tftypes.String, serviceName)
+		values["host"] = tftypes.NewValue(tftypes.String, "app.example.com")
+		values["compose_services"] = composeServices
+		return tftypes.NewValue(objectType, values)
+	}
+	services := tftypes.NewValue(listType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "db"),
+		tftypes.NewValue(tftypes.String, "web"),
+	})
+
+	tests := map[string]struct {
+		serviceName     string
+		composeServices tftypes.Value
+		wantError       bool
+	}{
+		"defined service":           {serviceName: "web", composeServices: services},
+		"undefined service":         {serviceName: "api", composeServices: services, wantError: true},
+		"services not known yet":    {serviceName: "api", composeServices: tftypes.NewValue(listType, tftypes.UnknownValue)},
+		"compose_services not used": {serviceName: "api", composeServices: tftypes.NewValue(listType, nil)},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(test.serviceName, test.composeServices)}
+			req := resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
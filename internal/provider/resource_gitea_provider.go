@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func NewGiteaProviderResource() resource.Resource {
+	return &gitProviderResource[GiteaProviderResourceModel, client.GiteaProvider]{spec: giteaProviderSpec}
+}
+
+type GiteaProviderResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	GitProviderID      types.String `tfsdk:"git_provider_id"`
+	Name               types.String `tfsdk:"name"`
+	GiteaURL           types.String `tfsdk:"gitea_url"`
+	ClientID           types.String `tfsdk:"client_id"`
+	RedirectURI        types.String `tfsdk:"redirect_uri"`
+	ClientSecret       types.String `tfsdk:"client_secret"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+}
+
+var giteaProviderSpec = gitProviderResourceSpec[GiteaProviderResourceModel, client.GiteaProvider]{
+	SourceType:  "gitea",
+	DisplayName: "Gitea",
+	Attributes: map[string]schema.Attribute{
+		"gitea_url": schema.StringAttribute{
+			Required:    true,
+			Description: "Base URL of the Gitea instance, e.g. https://git.example.com.",
+		},
+		"client_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "Client ID of the Gitea OAuth2 application.",
+		},
+		"redirect_uri": schema.StringAttribute{
+			Optional:    true,
+			Description: "Redirect URI registered on the Gitea OAuth2 application.",
+		},
+	},
+	Secrets: []gitProviderSecret{
+		{Name: "client_secret", Description: "Client secret of the Gitea OAuth2 application."},
+		{Name: "access_token", Description: "Gitea access token with repository read access."},
+	},
+	Validate:   validateGiteaProvider,
+	FromPlan:   giteaProviderFromPlan,
+	ApplyState: applyGiteaProviderState,
+	Create:     (*client.DokployClient).CreateGiteaProvider,
+	Get:        (*client.DokployClient).GetGiteaProvider,
+	Update:     (*client.DokployClient).UpdateGiteaProvider,
+}
+
+func validateGiteaProvider(config GiteaProviderResourceModel, diags *diag.Diagnostics) {
+	if !config.ClientID.IsNull() && config.ClientSecret.IsNull() {
+		diags.AddAttributeError(
+			path.Root("client_secret"),
+			"Missing Gitea Client Secret",
+			"client_secret is required when client_id is set.",
+		)
+	}
+	if config.ClientID.IsNull() && config.AccessToken.IsNull() {
+		diags.AddAttributeError(
+			path.Root("access_token"),
+			"Missing Gitea Credentials",
+			"Either access_token or client_id and client_secret must be set.",
+		)
+	}
+}
+
+// giteaProviderFromPlan builds the API request. Secrets are write-only and
+// therefore taken from the configuration.
+func giteaProviderFromPlan(plan, config GiteaProviderResourceModel) client.GiteaProvider {
+	return client.GiteaProvider{
+		ID:            plan.ID.ValueString(),
+		GitProviderID: plan.GitProviderID.ValueString(),
+		Name:          plan.Name.ValueString(),
+		GiteaURL:      plan.GiteaURL.ValueString(),
+		ClientID:      optionalStringFromPlan(plan.ClientID),
+		RedirectURI:   optionalStringFromPlan(plan.RedirectURI),
+		ClientSecret:  optionalStringFromPlan(config.ClientSecret),
+		AccessToken:   optionalStringFromPlan(config.AccessToken),
+	}
+}
+
+func applyGiteaProviderState(state GiteaProviderResourceModel, provider *client.GiteaProvider) GiteaProviderResourceModel {
+	state.ID = types.StringValue(provider.ID)
+	state.GitProviderID = types.StringValue(provider.GitProviderID)
+	if provider.Name != "" {
+		state.Name = types.StringValue(provider.Name)
+	}
+	if provider.GiteaURL != "" {
+		state.GiteaURL = types.StringValue(provider.GiteaURL)
+	}
+	state.ClientID = optionalStringState(state.ClientID, provider.ClientID)
+	state.RedirectURI = optionalStringState(state.RedirectURI, provider.RedirectURI)
+	return state
+}
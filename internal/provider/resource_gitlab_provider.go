@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+func NewGitlabProviderResource() resource.Resource {
+	return &gitProviderResource[GitlabProviderResourceModel, client.GitlabProvider]{spec: gitlabProviderSpec}
+}
+
+type GitlabProviderResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	GitProviderID      types.String `tfsdk:"git_provider_id"`
+	Name               types.String `tfsdk:"name"`
+	GitlabURL          types.String `tfsdk:"gitlab_url"`
+	ApplicationID      types.String `tfsdk:"application_id"`
+	RedirectURI        types.String `tfsdk:"redirect_uri"`
+	GroupName          types.String `tfsdk:"group_name"`
+	Secret             types.String `tfsdk:"secret"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+}
+
+var gitlabProviderSpec = gitProviderResourceSpec[GitlabProviderResourceModel, client.GitlabProvider]{
+	SourceType:  "gitlab",
+	DisplayName: "GitLab",
+	Attributes: map[string]schema.Attribute{
+		"gitlab_url": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("https://gitlab.com"),
+			Description: "Base URL of the GitLab instance. Defaults to https://gitlab.com.",
+		},
+		"application_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "Application ID of the GitLab OAuth application.",
+		},
+		"redirect_uri": schema.StringAttribute{
+			Optional:    true,
+			Description: "Redirect URI registered on the GitLab OAuth application.",
+		},
+		"group_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "Limits the repositories offered by Dokploy to this group.",
+		},
+	},
+	Secrets: []gitProviderSecret{
+		{Name: "secret", Description: "Secret of the GitLab OAuth application."},
+		{Name: "access_token", Description: "Personal, group or project access token with api and read_repository scopes."},
+	},
+	Validate:   validateGitlabProvider,
+	FromPlan:   gitlabProviderFromPlan,
+	ApplyState: applyGitlabProviderState,
+	Create:     (*client.DokployClient).CreateGitlabProvider,
+	Get:        (*client.DokployClient).GetGitlabProvider,
+	Update:     (*client.DokployClient).UpdateGitlabProvider,
+}
+
+func validateGitlabProvider(config GitlabProviderResourceModel, diags *diag.Diagnostics) {
+	if !config.ApplicationID.IsNull() && config.Secret.IsNull() {
+		diags.AddAttributeError(
+			path.Root("secret"),
+			"Missing GitLab Secret",
+			"secret is required when application_id is set.",
+		)
+	}
+	if config.ApplicationID.IsNull() && config.AccessToken.IsNull() {
+		diags.AddAttributeError(
+			path.Root("access_token"),
+			"Missing GitLab Credentials",
+			"Either access_token or application_id and secret must be set.",
+		)
+	}
+}
+
+// gitlabProviderFromPlan builds the API request. Secrets are write-only and
+// therefore taken from the configuration.
+func gitlabProviderFromPlan(plan, config GitlabProviderResourceModel) client.GitlabProvider {
+	return client.GitlabProvider{
+		ID:            plan.ID.ValueString(),
+		GitProviderID: plan.GitProviderID.ValueString(),
+		Name:          plan.Name.ValueString(),
+		GitlabURL:     plan.GitlabURL.ValueString(),
+		ApplicationID: optionalStringFromPlan(plan.ApplicationID),
+		RedirectURI:   optionalStringFromPlan(plan.RedirectURI),
+		GroupName:     optionalStringFromPlan(plan.GroupName),
+		Secret:        optionalStringFromPlan(config.Secret),
+		AccessToken:   optionalStringFromPlan(config.AccessToken),
+	}
+}
+
+func applyGitlabProviderState(state GitlabProviderResourceModel, provider *client.GitlabProvider) GitlabProviderResourceModel {
+	state.ID = types.StringValue(provider.ID)
+	state.GitProviderID = types.StringValue(provider.GitProviderID)
+	if provider.Name != "" {
+		state.Name = types.StringValue(provider.Name)
+	}
+	if provider.GitlabURL != "" {
+		state.GitlabURL = types.StringValue(provider.GitlabURL)
+	}
+	state.ApplicationID = optionalStringState(state.ApplicationID, provider.ApplicationID)
+	state.RedirectURI = optionalStringState(state.RedirectURI, provider.RedirectURI)
+	state.GroupName = optionalStringState(state.GroupName, provider.GroupName)
+	return state
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const nanoCPUsPerCPU = 1_000_000_000
+
+var dockerMemoryPattern = regexp.MustCompile(`^(?i)(\d+(?:\.\d+)?)\s*([kmgtp])?i?b?$`)
+
+var dockerMemoryUnits = map[string]int64{
+	"":  1,
+	"k": 1 << 10,
+	"m": 1 << 20,
+	"g": 1 << 30,
+	"t": 1 << 40,
+	"p": 1 << 50,
+}
+
+// parseDockerMemory converts a Docker memory value such as "512m", "1.5g" or
+// a plain byte count into bytes, using the same binary units as docker run.
+func parseDockerMemory(value string) (int64, error) {
+	matches := dockerMemoryPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid memory value %q: expected a byte count or a number with a b, k, m, g, t or p suffix, e.g. 512m", value)
+	}
+	size, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory value %q: %w", value, err)
+	}
+	bytes := int64(size * float64(dockerMemoryUnits[strings.ToLower(matches[2])]))
+	if bytes <= 0 {
+		return 0, fmt.Errorf("invalid memory value %q: must be greater than zero", value)
+	}
+	return bytes, nil
+}
+
+// parseDockerCPUs converts a Docker --cpus value such as "0.5" or "2" into
+// nano CPUs, the unit Dokploy stores.
+func parseDockerCPUs(value string) (int64, error) {
+	trimmed := strings.TrimSpace(value)
+	whole, fraction, _ := strings.Cut(trimmed, ".")
+	if whole == "" {
+		whole = "0"
+	}
+	if !isDigits(whole) || (fraction != "" && !isDigits(fraction)) || len(fraction) > 9 || trimmed == "" || trimmed == "." {
+		return 0, fmt.Errorf("invalid CPU value %q: expected a decimal number of CPUs with at most 9 decimal places, e.g. 0.5", value)
+	}
+	cpus, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || cpus > (1<<63-1)/nanoCPUsPerCPU-1 {
+		return 0, fmt.Errorf("invalid CPU value %q: out of range", value)
+	}
+	nanoFraction := int64(0)
+	if fraction != "" {
+		nanoFraction, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	}
+	nanoCPUs := cpus*nanoCPUsPerCPU + nanoFraction
+	if nanoCPUs <= 0 {
+		return 0, fmt.Errorf("invalid CPU value %q: must be greater than zero", value)
+	}
+	return nanoCPUs, nil
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// formatNanoCPUs renders nano CPUs in the decimal form accepted by --cpus.
+func formatNanoCPUs(nanoCPUs int64) string {
+	whole, fraction := nanoCPUs/nanoCPUsPerCPU, nanoCPUs%nanoCPUsPerCPU
+	if fraction == 0 {
+		return strconv.FormatInt(whole, 10)
+	}
+	return strings.TrimRight(fmt.Sprintf("%d.%09d", whole, fraction), "0")
+}
+
+func memoryQuantityFromPlan(value types.String) string {
+	bytes, err := parseDockerMemory(optionalStringFromPlan(value))
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatInt(bytes, 10)
+}
+
+func cpuQuantityFromPlan(value types.String) string {
+	nanoCPUs, err := parseDockerCPUs(optionalStringFromPlan(value))
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatInt(nanoCPUs, 10)
+}
+
+// memoryQuantityState refreshes a memory attribute from the byte count Dokploy
+// reports, keeping the configured spelling when it is equivalent.
+func memoryQuantityState(current types.String, reported string) types.String {
+	return quantityState(current, reported, parseDockerMemory, func(bytes int64) string {
+		return strconv.FormatInt(bytes, 10)
+	})
+}
+
+// cpuQuantityState refreshes a CPU attribute from the nano CPUs Dokploy
+// reports, keeping the configured spelling when it is equivalent.
+func cpuQuantityState(current types.String, reported string) types.String {
+	return quantityState(current, reported, parseDockerCPUs, formatNanoCPUs)
+}
+
+// quantityState reports the remote value whether or not the attribute is
+// configured, so limits set in the Dokploy UI show up as drift. Dokploy
+// reports unset limits as empty, which is null.
+func quantityState(current types.String, reported string, parse func(string) (int64, error), format func(int64) string) types.String {
+	reported = strings.TrimSpace(reported)
+	if reported == "" {
+		return types.StringNull()
+	}
+	reportedValue, err := strconv.ParseInt(reported, 10, 64)
+	if err != nil {
+		return types.StringValue(reported)
+	}
+	if currentValue, err := parse(current.ValueString()); err == nil && currentValue == reportedValue {
+		return current
+	}
+	return types.StringValue(format(reportedValue))
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDockerMemory(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int64
+		wantErr  bool
+	}{
+		{value: "536870912", expected: 536870912},
+		{value: "512m", expected: 512 << 20},
+		{value: "512MB", expected: 512 << 20},
+		{value: "1.5g", expected: 3 << 29},
+		{value: "2Gi", expected: 2 << 30},
+		{value: "64k", expected: 64 << 10},
+		{value: "0", wantErr: true},
+		{value: "-1g", wantErr: true},
+		{value: "lots", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			got, err := parseDockerMemory(test.value)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.expected {
+				t.Fatalf("unexpected bytes: got %d want %d", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestParseDockerCPUs(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int64
+		wantErr  bool
+	}{
+		{value: "2", expected: 2_000_000_000},
+		{value: "0.5", expected: 500_000_000},
+		{value: ".25", expected: 250_000_000},
+		{value: "1.000000001", expected: 1_000_000_001},
+		{value: "1.0000000001", wantErr: true},
+		{value: "0", wantErr: true},
+		{value: "1e9", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: ".", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			got, err := parseDockerCPUs(test.value)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.expected {
+				t.Fatalf("unexpected nano CPUs: got %d want %d", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestQuantityState(t *testing.T) {
+	if got := memoryQuantityState(types.StringValue("512m"), "536870912"); got.ValueString() != "512m" {
+		t.Fatalf("expected equivalent memory value to keep configured spelling, got %s", got)
+	}
+	if got := memoryQuantityState(types.StringValue("512m"), "1073741824"); got.ValueString() != "1073741824" {
+		t.Fatalf("expected changed memory value to surface as drift, got %s", got)
+	}
+	if got := cpuQuantityState(types.StringValue("0.5"), "500000000"); got.ValueString() != "0.5" {
+		t.Fatalf("expected equivalent CPU value to keep configured spelling, got %s", got)
+	}
+	if got := cpuQuantityState(types.StringValue("0.5"), "1250000000"); got.ValueString() != "1.25" {
+		t.Fatalf("expected changed CPU value to be rendered in CPUs, got %s", got)
+	}
+	if got := cpuQuantityState(types.StringValue("1"), ""); !got.IsNull() {
+		t.Fatalf("expected cleared CPU value to become null, got %s", got)
+	}
+	if got := cpuQuantityState(types.StringNull(), "500000000"); got.ValueString() != "0.5" {
+		t.Fatalf("expected a CPU limit set outside Terraform to surface as drift, got %s", got)
+	}
+	if got := memoryQuantityState(types.StringNull(), "536870912"); got.ValueString() != "536870912" {
+		t.Fatalf("expected a memory limit set outside Terraform to surface as drift, got %s", got)
+	}
+	if got := memoryQuantityState(types.StringNull(), ""); !got.IsNull() {
+		t.Fatalf("expected an unset memory limit to stay null, got %s", got)
+	}
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j0bit/terraform-provider-dokploy/internal/client"
+)
+
+var _ resource.Resource = &MountResource{}
+var _ resource.ResourceWithImportState = &MountResource{}
+var _ resource.ResourceWithValidateConfig = &MountResource{}
+
+func NewMountResource() resource.Resource {
+	return &MountResource{}
+}
+
+type MountResource struct {
+	client *client.DokployClient
+}
+
+type MountResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ServiceType types.String `tfsdk:"service_type"`
+	ServiceID   types.String `tfsdk:"service_id"`
+	Type        types.String `tfsdk:"type"`
+	MountPath   types.String `tfsdk:"mount_path"`
+	VolumeName  types.String `tfsdk:"volume_name"`
+	HostPath    types.String `tfsdk:"host_path"`
+	Content     types.String `tfsdk:"content"`
+	FilePath    types.String `tfsdk:"file_path"`
+}
+
+func (r *MountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mount"
+}
+
+func (r *MountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a volume, bind or file mount on a Dokploy application, compose stack or database. It can be combined with the inline mounts of dokploy_application as long as both use different mount paths; managing one mount path with both is unsupported.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the service that owns the mount: application, compose, postgres, mysql, mariadb, mongo or redis.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service that owns the mount.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Mount type: volume, bind or file. Changing it replaces the mount, since Dokploy cannot convert a mount to another type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mount_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path inside the container.",
+			},
+			"volume_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Docker volume name. Required for volume mounts.",
+			},
+			"host_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path on the host. Required for bind mounts.",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "File content. Required for file mounts.",
+			},
+			"file_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the file Dokploy stores the content in. Required for file mounts.",
+			},
+		},
+	}
+}
+
+func (r *MountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MountResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ServiceType.IsUnknown() && !config.ServiceType.IsNull() && !client.IsMountServiceType(config.ServiceType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("service_type"),
+			"Invalid Service Type",
+			fmt.Sprintf("Unsupported service type %q. Expected one of application, compose, postgres, mysql, mariadb, mongo or redis.", config.ServiceType.ValueString()),
+		)
+	}
+
+	if config.Type.IsUnknown() || config.Type.IsNull() {
+		return
+	}
+	for _, attribute := range missingMountAttributes(config) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Missing Mount Attribute",
+			fmt.Sprintf("%s is required for %s mounts.", attribute, config.Type.ValueString()),
+		)
+	}
+}
+
+// missingMountAttributes returns the type-specific attributes a mount
+// configuration lacks. Unknown values are assumed to be set.
+func missingMountAttributes(config MountResourceModel) []string {
+	isMissing := func(value types.String) bool {
+		return value.IsNull() || (!value.IsUnknown() && strings.TrimSpace(value.ValueString()) == "")
+	}
+
+	var missing []string
+	switch config.Type.ValueString() {
+	case "volume":
+		if isMissing(config.VolumeName) {
+			missing = append(missing, "volume_name")
+		}
+	case "bind":
+		if isMissing(config.HostPath) {
+			missing = append(missing, "host_path")
+		}
+	case "file":
+		if config.Content.IsNull() {
+			missing = append(missing, "content")
+		}
+		if isMissing(config.FilePath) {
+			missing = append(missing, "file_path")
+		}
+	default:
+		missing = append(missing, "type")
+	}
+	return missing
+}
+
+func (r *MountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func mountFromModel(model MountResourceModel) client.Mount {
+	return client.Mount{
+		ID:          model.ID.ValueString(),
+		ServiceType: model.ServiceType.ValueString(),
+		ServiceID:   model.ServiceID.ValueString(),
+		Type:        model.Type.ValueString(),
+		MountType:   model.Type.ValueString(),
+		MountPath:   strings.TrimSpace(model.MountPath.ValueString()),
+		VolumeName:  strings.TrimSpace(optionalStringFromPlan(model.VolumeName)),
+		HostPath:    strings.TrimSpace(optionalStringFromPlan(model.HostPath)),
+		Content:     optionalStringFromPlan(model.Content),
+		FilePath:    strings.TrimSpace(optionalStringFromPlan(model.FilePath)),
+	}
+}
+
+func (r *MountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdMount, err := r.client.CreateMount(mountFromModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating mount", err.Error())
+		return
+	}
+	if strings.TrimSpace(createdMount.ID) == "" {
+		resp.Diagnostics.AddError("Error creating mount", "Dokploy did not return a mount ID")
+		return
+	}
+
+	plan.ID = types.StringValue(createdMount.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported mounts only carry an ID; resolve the owning service first.
+	if state.ServiceType.IsNull() || state.ServiceID.IsNull() {
+		mount, err := r.client.GetMount(state.ID.ValueString())
+		if err != nil {
+			if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Error reading mount", err.Error())
+			return
+		}
+		serviceType, serviceID := mount.Target()
+		state.ServiceType = types.StringValue(serviceType)
+		state.ServiceID = types.StringValue(serviceID)
+	}
+
+	mounts, err := r.client.ListMounts(state.ServiceType.ValueString(), state.ServiceID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading mount", err.Error())
+		return
+	}
+
+	var mount *client.Mount
+	for i := range mounts {
+		if mounts[i].ID == state.ID.ValueString() {
+			mount = &mounts[i]
+			break
+		}
+	}
+	if mount == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if mountType := mount.EffectiveType(); mountType != "" {
+		state.Type = types.StringValue(mountType)
+	}
+	state.MountPath = types.StringValue(mount.MountPath)
+	state.VolumeName = optionalStringState(state.VolumeName, mount.VolumeName)
+	state.HostPath = optionalStringState(state.HostPath, mount.HostPath)
+	state.Content = optionalStringState(state.Content, mount.Content)
+	state.FilePath = optionalStringState(state.FilePath, mount.FilePath)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.UpdateMount(mountFromModel(plan)); err != nil {
+		resp.Diagnostics.AddError("Error updating mount", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMount(mountFromModel(state))
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting mount", err.Error())
+		return
+	}
+}
+
+func (r *MountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
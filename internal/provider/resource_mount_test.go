@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMissingMountAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   MountResourceModel
+		expected []string
+	}{
+		{
+			name:   "volume with name",
+			config: MountResourceModel{Type: types.StringValue("volume"), VolumeName: types.StringValue("data")},
+		},
+		{
+			name:     "volume without name",
+			config:   MountResourceModel{Type: types.StringValue("volume"), VolumeName: types.StringNull()},
+			expected: []string{"volume_name"},
+		},
+		{
+			name:     "bind with blank host path",
+			config:   MountResourceModel{Type: types.StringValue("bind"), HostPath: types.StringValue(" ")},
+			expected: []string{"host_path"},
+		},
+		{
+			name:   "bind with unknown host path",
+			config: MountResourceModel{Type: types.StringValue("bind"), HostPath: types.StringUnknown()},
+		},
+		{
+			name:   "file with empty content",
+			config: MountResourceModel{Type: types.StringValue("file"), Content: types.StringValue(""), FilePath: types.StringValue("app.conf")},
+		},
+		{
+			name:     "file without content or path",
+			config:   MountResourceModel{Type: types.StringValue("file"), Content: types.StringNull(), FilePath: types.StringNull()},
+			expected: []string{"content", "file_path"},
+		},
+		{
+			name:     "unknown type",
+			config:   MountResourceModel{Type: types.StringValue("tmpfs")},
+			expected: []string{"type"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := missingMountAttributes(test.config)
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Fatalf("unexpected missing attributes: got %v want %v", got, test.expected)
+			}
+		})
+	}
+}
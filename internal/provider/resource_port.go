@@ -240,7 +240,10 @@ func (r *PortResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeletePort(state.ID.ValueString())
+	err := r.client.DeletePort(client.Port{
+		ID:            state.ID.ValueString(),
+		ApplicationID: state.ApplicationID.ValueString(),
+	})
 	if err != nil {
 		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
 			return
@@ -28,11 +28,11 @@ type TraefikConfigResource struct {
 }
 
 type TraefikConfigResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Scope         types.String `tfsdk:"scope"`
-	ServerID      types.String `tfsdk:"server_id"`
-	Config        types.String `tfsdk:"config"`
-	ReloadOnApply types.Bool   `tfsdk:"reload_on_apply"`
+	ID            types.String    `tfsdk:"id"`
+	Scope         types.String    `tfsdk:"scope"`
+	ServerID      types.String    `tfsdk:"server_id"`
+	Config        YAMLStringValue `tfsdk:"config"`
+	ReloadOnApply types.Bool      `tfsdk:"reload_on_apply"`
 }
 
 func (r *TraefikConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,8 +66,9 @@ func (r *TraefikConfigResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "Optional Dokploy server ID for multi-server deployments.",
 			},
 			"config": schema.StringAttribute{
+				CustomType:  YAMLStringType{},
 				Required:    true,
-				Description: "Full Traefik configuration content to persist in Dokploy settings.",
+				Description: "Full Traefik configuration content to persist in Dokploy settings. Must be valid YAML; formatting differences such as indentation, key order and quoting are not reported as changes.",
 			},
 			"reload_on_apply": schema.BoolAttribute{
 				Optional:    true,
@@ -149,7 +150,7 @@ func (r *TraefikConfigResource) Read(ctx context.Context, req resource.ReadReque
 
 	state.Scope = types.StringValue(scope)
 	state.ID = types.StringValue(traefikConfigStateID(scope, state.ServerID))
-	state.Config = types.StringValue(config)
+	state.Config = NewYAMLStringValue(config)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -18,6 +18,7 @@ import (
 
 var _ resource.Resource = &VolumeBackupResource{}
 var _ resource.ResourceWithImportState = &VolumeBackupResource{}
+var _ resource.ResourceWithModifyPlan = &VolumeBackupResource{}
 
 func NewVolumeBackupResource() resource.Resource {
 	return &VolumeBackupResource{}
@@ -40,6 +41,8 @@ type VolumeBackupResourceModel struct {
 	Prefix          types.String `tfsdk:"prefix"`
 	KeepLatestCount types.Int64  `tfsdk:"keep_latest_count"`
 	Enabled         types.Bool   `tfsdk:"enabled"`
+	ComposeServices types.List   `tfsdk:"compose_services"`
+	ComposeVolumes  types.List   `tfsdk:"compose_volumes"`
 }
 
 func (r *VolumeBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -119,6 +122,16 @@ func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 				Description: "Whether the backup schedule is enabled. Defaults to true.",
 			},
+			"compose_services": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Services of the compose stack, usually dokploy_compose.<name>.services. If set, service_name must be one of them, which checks it against the planned compose file. Otherwise service_name is checked against the compose file Dokploy currently holds and an unknown service is only a warning.",
+			},
+			"compose_volumes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Volumes of the compose stack, usually dokploy_compose.<name>.volumes. If set, volume_name must be one of them, with or without the app_name prefix. Otherwise volume_name is checked against the compose file Dokploy currently holds and an unknown volume is only a warning.",
+			},
 		},
 	}
 }
@@ -135,6 +148,75 @@ func (r *VolumeBackupResource) Configure(_ context.Context, req resource.Configu
 	r.client = client
 }
 
+// ModifyPlan checks that service_name and volume_name are defined by the
+// compose stack. With compose_services or compose_volumes set, they are
+// checked against the planned compose file and an unknown name is an error.
+// Otherwise they are checked against the compose file Dokploy holds for
+// compose_id, which may still change in the same apply, so an unknown name
+// is only a warning. Only new or changed references are checked.
+func (r *VolumeBackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan VolumeBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state VolumeBackupResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() ||
+			(plan.ComposeID.Equal(state.ComposeID) && plan.ServiceName.Equal(state.ServiceName) && plan.VolumeName.Equal(state.VolumeName) &&
+				plan.ComposeServices.Equal(state.ComposeServices) && plan.ComposeVolumes.Equal(state.ComposeVolumes)) {
+			return
+		}
+	}
+
+	declaredServices, servicesDeclared, diags := declaredComposeNames(ctx, plan.ComposeServices)
+	resp.Diagnostics.Append(diags...)
+	declaredVolumes, volumesDeclared, diags := declaredComposeNames(ctx, plan.ComposeVolumes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names *composeFileNames
+	if (!servicesDeclared && !plan.ComposeServices.IsUnknown()) || (!volumesDeclared && !plan.ComposeVolumes.IsUnknown()) {
+		names = deployedComposeFile(r.client, plan.ComposeID)
+	}
+	composeID := plan.ComposeID.ValueString()
+
+	switch {
+	case servicesDeclared:
+		requireComposeName(&resp.Diagnostics, "service_name", "Service", plan.ServiceName, declaredServices, "compose_services")
+	case names != nil && !plan.ComposeServices.IsUnknown():
+		warnUnknownComposeName(&resp.Diagnostics, "service_name", "Service", plan.ServiceName, names.Services, composeID)
+	}
+
+	appName := ""
+	if names != nil {
+		appName = names.AppName
+	}
+	if !plan.AppName.IsNull() && !plan.AppName.IsUnknown() {
+		appName = plan.AppName.ValueString()
+	}
+	volumeName := plan.VolumeName
+	if !volumeName.IsNull() && !volumeName.IsUnknown() {
+		volumeName = types.StringValue(stripComposeVolumePrefix(appName, volumeName.ValueString()))
+	}
+	switch {
+	case volumesDeclared:
+		if appName == "" && !volumeName.IsNull() && !volumeName.IsUnknown() {
+			volumeName = types.StringValue(matchPrefixedComposeVolume(volumeName.ValueString(), declaredVolumes))
+		}
+		requireComposeName(&resp.Diagnostics, "volume_name", "Volume", volumeName, declaredVolumes, "compose_volumes")
+	case names != nil && !plan.ComposeVolumes.IsUnknown():
+		warnUnknownComposeName(&resp.Diagnostics, "volume_name", "Volume", volumeName, names.Volumes, composeID)
+	}
+}
+
 func (r *VolumeBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan VolumeBackupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -414,6 +496,23 @@ func resolveComposeVolumeName(appName, volumeName string) string {
 	return prefix + volumeName
 }
 
+// matchPrefixedComposeVolume returns the volume of defined that volumeName
+// refers to when the app name prefix is not known yet, e.g. on create with
+// app_name omitted. volumeName is returned as is if no volume matches.
+func matchPrefixedComposeVolume(volumeName string, defined []string) string {
+	for _, candidate := range defined {
+		if volumeName == candidate {
+			return volumeName
+		}
+	}
+	for _, candidate := range defined {
+		if strings.HasSuffix(volumeName, "_"+candidate) {
+			return candidate
+		}
+	}
+	return volumeName
+}
+
 func stripComposeVolumePrefix(appName, volumeName string) string {
 	appName = strings.TrimSpace(appName)
 	volumeName = strings.TrimSpace(volumeName)
@@ -45,3 +45,18 @@ func TestStripComposeVolumePrefix(t *testing.T) {
 		t.Fatalf("unexpected value: got %q want %q", got, "ghost-mysql-data")
 	}
 }
+
+func TestMatchPrefixedComposeVolume(t *testing.T) {
+	defined := []string{"data", "db-data"}
+	tests := map[string]string{
+		"db-data":            "db-data",
+		"shop-x1y2z3_data":   "data",
+		"shop-x1y2z3_cache":  "shop-x1y2z3_cache",
+		"external-db-volume": "external-db-volume",
+	}
+	for volumeName, want := range tests {
+		if got := matchPrefixedComposeVolume(volumeName, defined); got != want {
+			t.Fatalf("matchPrefixedComposeVolume(%q) = %q, want %q", volumeName, got, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var _ basetypes.StringTypable = YAMLStringType{}
+var _ basetypes.StringValuableWithSemanticEquals = YAMLStringValue{}
+var _ xattr.ValidateableAttribute = YAMLStringValue{}
+
+// YAMLStringType is a string attribute holding a YAML document. Values that
+// parse to the same data are equal, so re-serialization by Dokploy (indentation,
+// key order, quoting) does not show up as drift.
+type YAMLStringType struct {
+	basetypes.StringType
+}
+
+func (t YAMLStringType) String() string {
+	return "YAMLStringType"
+}
+
+func (t YAMLStringType) ValueType(_ context.Context) attr.Value {
+	return YAMLStringValue{}
+}
+
+func (t YAMLStringType) Equal(o attr.Type) bool {
+	other, ok := o.(YAMLStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t YAMLStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return YAMLStringValue{StringValue: in}, nil
+}
+
+func (t YAMLStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return YAMLStringValue{StringValue: stringValue}, nil
+}
+
+// YAMLStringValue is the value of a YAMLStringType attribute.
+type YAMLStringValue struct {
+	basetypes.StringValue
+}
+
+func NewYAMLStringValue(value string) YAMLStringValue {
+	return YAMLStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v YAMLStringValue) Type(_ context.Context) attr.Type {
+	return YAMLStringType{}
+}
+
+func (v YAMLStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(YAMLStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values parse to the same YAML
+// data. Values that do not parse are only equal as identical strings.
+func (v YAMLStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(YAMLStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	if v.ValueString() == newValue.ValueString() {
+		return true, diags
+	}
+
+	current, err := parseYAMLDocument(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	updated, err := parseYAMLDocument(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return reflect.DeepEqual(current, updated), diags
+}
+
+// ValidateAttribute rejects values that are not valid YAML at plan time.
+func (v YAMLStringValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if _, err := parseYAMLDocument(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML",
+			fmt.Sprintf("The value is not a valid YAML document: %s", err),
+		)
+	}
+}
+
+func parseYAMLDocument(content string) (interface{}, error) {
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
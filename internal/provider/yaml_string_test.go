@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestYAMLStringValue_StringSemanticEquals(t *testing.T) {
+	planned := `http:
+  routers:
+    web:
+      rule: "Host(` + "`web.example.com`" + `)"
+      entryPoints: [web, websecure]
+  services:
+    web:
+      loadBalancer:
+        servers:
+          - url: http://web:3000
+`
+	tests := []struct {
+		name     string
+		remote   string
+		expected bool
+	}{
+		{
+			name: "reserialized",
+			remote: `http:
+    services:
+        web:
+            loadBalancer:
+                servers:
+                    - url: 'http://web:3000'
+    routers:
+        web:
+            entryPoints:
+                - web
+                - websecure
+            rule: Host(` + "`web.example.com`" + `)
+`,
+			expected: true,
+		},
+		{
+			name:     "changed value",
+			remote:   "http:\n  routers:\n    web:\n      rule: Host(`other.example.com`)\n",
+			expected: false,
+		},
+		{
+			name:     "invalid yaml",
+			remote:   "http: [",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			equal, diags := NewYAMLStringValue(planned).StringSemanticEquals(context.Background(), NewYAMLStringValue(test.remote))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != test.expected {
+				t.Fatalf("unexpected result: got %v want %v", equal, test.expected)
+			}
+		})
+	}
+}
+
+func TestYAMLStringValue_StringSemanticEqualsTypeMismatch(t *testing.T) {
+	_, diags := NewYAMLStringValue("a: 1").StringSemanticEquals(context.Background(), basetypes.NewStringValue("a: 1"))
+	if !diags.HasError() {
+		t.Fatal("expected an error for a plain string value")
+	}
+}
+
+func TestYAMLStringValue_ValidateAttribute(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     YAMLStringValue
+		wantError bool
+	}{
+		{name: "valid", value: NewYAMLStringValue("http:\n  routers: {}\n")},
+		{name: "invalid", value: NewYAMLStringValue("http:\n  routers: {\n"), wantError: true},
+		{name: "tab indentation", value: NewYAMLStringValue("http:\n\trouters: {}\n"), wantError: true},
+		{name: "null", value: YAMLStringValue{StringValue: basetypes.NewStringNull()}},
+		{name: "unknown", value: YAMLStringValue{StringValue: basetypes.NewStringUnknown()}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			test.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("config")}, resp)
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestYAMLStringType_ValueFromTerraform(t *testing.T) {
+	value, err := YAMLStringType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, "a: 1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	yamlValue, ok := value.(YAMLStringValue)
+	if !ok || yamlValue.ValueString() != "a: 1" {
+		t.Fatalf("unexpected value: %#v", value)
+	}
+	if !yamlValue.Type(context.Background()).Equal(YAMLStringType{}) {
+		t.Fatal("expected the value to report YAMLStringType")
+	}
+}